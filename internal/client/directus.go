@@ -3,11 +3,17 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// pageSize is the number of items requested per page by GetAll
+const pageSize = 100
+
 type Directus struct {
 	baseURL string
 	http    *http.Client
@@ -22,6 +28,9 @@ func NewDirectus(baseURL, token string, timeout time.Duration) *Directus {
 	}
 }
 
+// BaseURL returns the base URL of the Directus instance, without a trailing slash
+func (c *Directus) BaseURL() string { return c.baseURL }
+
 func (c *Directus) Request(ctx context.Context, method, path string, body any) (*http.Response, error) {
 	var reqBody *strings.Reader
 	if body != nil {
@@ -44,3 +53,84 @@ func (c *Directus) Request(ctx context.Context, method, path string, body any) (
 	}
 	return c.http.Do(req)
 }
+
+// GetAll lists every item of a collection endpoint such as /roles or
+// /items/articles, requesting pages until a short one is returned so results
+// aren't truncated at the server's default limit. A limit in query caps the
+// total number of items returned. Pages are sorted by id unless query sets a
+// sort, which callers must do for collections keyed by another field, so rows
+// aren't skipped or repeated across pages.
+func (c *Directus) GetAll(ctx context.Context, path string, query url.Values) ([]map[string]any, error) {
+	max := -1
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit %q: %v", v, err)
+		}
+		max = n
+	}
+
+	var all []map[string]any
+	for {
+		limit := pageSize
+		if max >= 0 && max-len(all) < limit {
+			limit = max - len(all)
+		}
+		if limit == 0 {
+			return all, nil
+		}
+
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		if q.Get("sort") == "" {
+			q.Set("sort", "id")
+		}
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("offset", strconv.Itoa(len(all)))
+
+		resp, err := c.Request(ctx, http.MethodGet, path+"?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		out := struct {
+			Data []map[string]any `json:"data"`
+		}{}
+		err = ParseResponseNumbers(resp, &out)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, out.Data...)
+		if len(out.Data) < limit {
+			return all, nil
+		}
+	}
+}
+
+// ParseResponse returns the API error for a failed response, otherwise it
+// decodes the body into out unless out is nil.
+func ParseResponse(resp *http.Response, out any) error {
+	if resp.StatusCode >= 300 {
+		var apiErr map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("directus api %d: %v", resp.StatusCode, apiErr)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ParseResponseNumbers is ParseResponse but keeps numbers as json.Number so
+// large integer keys aren't rounded.
+func ParseResponseNumbers(resp *http.Response, out any) error {
+	if resp.StatusCode >= 300 || out == nil {
+		return ParseResponse(resp, out)
+	}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	return dec.Decode(out)
+}
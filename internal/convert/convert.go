@@ -0,0 +1,80 @@
+// Package convert turns values decoded from Directus responses into
+// Terraform attribute values.
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Str formats a value returned by the API as a string, empty for null.
+func Str(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	case float64:
+		// %v would switch to exponent notation for large integer keys
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// BoolVal returns v if it is a bool, false otherwise.
+func BoolVal(v any) bool {
+	b, _ := v.(bool)
+	return b
+}
+
+// StrPtrToType converts a value returned by the API into a string attribute,
+// null for null or empty strings. Other values are stored as JSON.
+func StrPtrToType(v any) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	if s, ok := v.(string); ok {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(b))
+}
+
+// Int64PtrToType converts a number returned by the API, possibly encoded as
+// a string, into an int64 attribute, null when it isn't one.
+func Int64PtrToType(v any) types.Int64 {
+	switch t := v.(type) {
+	case float64:
+		return types.Int64Value(int64(t))
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return types.Int64Value(i)
+		}
+	case string:
+		if i, err := strconv.ParseInt(t, 10, 64); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+// BoolPtrToType converts a value returned by the API into a bool attribute,
+// null when it isn't a bool.
+func BoolPtrToType(v any) types.Bool {
+	if b, ok := v.(bool); ok {
+		return types.BoolValue(b)
+	}
+	return types.BoolNull()
+}
@@ -0,0 +1,213 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// CollectionDataSource implements the directus_collection data source
+type CollectionDataSource struct{ client *client.Directus }
+
+// CollectionDetailModel represents the collection data source model
+type CollectionDetailModel struct {
+	Collection types.String `tfsdk:"collection"`
+	System     types.Bool   `tfsdk:"system"`
+	Folder     types.Bool   `tfsdk:"folder"`
+	Singleton  types.Bool   `tfsdk:"singleton"`
+	Hidden     types.Bool   `tfsdk:"hidden"`
+	Group      types.String `tfsdk:"group"`
+	Meta       types.String `tfsdk:"meta"`   // JSON string
+	Schema     types.String `tfsdk:"schema"` // JSON string
+	Fields     []FieldModel `tfsdk:"fields"`
+}
+
+// FieldModel represents a single field of a collection
+type FieldModel struct {
+	Field             types.String `tfsdk:"field"`
+	Type              types.String `tfsdk:"type"`
+	Interface         types.String `tfsdk:"interface"`
+	Hidden            types.Bool   `tfsdk:"hidden"`
+	Readonly          types.Bool   `tfsdk:"readonly"`
+	Special           types.List   `tfsdk:"special"`
+	RelatedCollection types.String `tfsdk:"related_collection"`
+	Meta              types.String `tfsdk:"meta"`   // JSON string
+	Schema            types.String `tfsdk:"schema"` // JSON string
+}
+
+// NewCollectionDataSource returns a new collection data source
+func NewCollectionDataSource() datasource.DataSource { return &CollectionDataSource{} }
+
+// Metadata returns the data source type name
+func (d *CollectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+// Schema defines the schema for the data source
+func (d *CollectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := collectionAttributes()
+	attrs["collection"] = dschema.StringAttribute{Required: true}
+	attrs["fields"] = dschema.ListNestedAttribute{
+		Computed:     true,
+		NestedObject: dschema.NestedAttributeObject{Attributes: fieldAttributes()},
+	}
+	resp.Schema = dschema.Schema{Attributes: attrs}
+}
+
+// Configure configures the data source
+func (d *CollectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read reads the collection and its fields
+func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg CollectionDetailModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := url.PathEscape(cfg.Collection.ValueString())
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/collections/"+name, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	fields, err := readFields(ctx, d.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	c := flattenCollection(apiResp.Data)
+	cfg = CollectionDetailModel{
+		Collection: c.Collection,
+		System:     c.System,
+		Folder:     c.Folder,
+		Singleton:  c.Singleton,
+		Hidden:     c.Hidden,
+		Group:      c.Group,
+		Meta:       c.Meta,
+		Schema:     c.Schema,
+		Fields:     fields,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// Helper functions
+
+func fieldAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"field":     dschema.StringAttribute{Computed: true},
+		"type":      dschema.StringAttribute{Computed: true, Description: "Directus field type, e.g. string, uuid, alias"},
+		"interface": dschema.StringAttribute{Computed: true},
+		"hidden":    dschema.BoolAttribute{Computed: true},
+		"readonly":  dschema.BoolAttribute{Computed: true},
+		"special": dschema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "special flags, e.g. uuid, user-created, m2m",
+		},
+		"related_collection": dschema.StringAttribute{
+			Computed:    true,
+			Description: "collection a relational field points to; the junction collection for many-to-many",
+		},
+		"meta":   dschema.StringAttribute{Computed: true, Description: "JSON string of the field meta"},
+		"schema": dschema.StringAttribute{Computed: true, Description: "JSON string of the database column"},
+	}
+}
+
+// readFields lists the fields of an already escaped collection name, along
+// with the collection each relational field points to
+func readFields(ctx context.Context, c *client.Directus, collection string) ([]FieldModel, error) {
+	apiResp := struct {
+		Data []map[string]any `json:"data"`
+	}{}
+	httpResp, err := c.Request(ctx, http.MethodGet, "/fields/"+collection, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+		return nil, err
+	}
+
+	relations := struct {
+		Data []map[string]any `json:"data"`
+	}{}
+	relResp, err := c.Request(ctx, http.MethodGet, "/relations", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer relResp.Body.Close()
+	if err := client.ParseResponse(relResp, &relations); err != nil {
+		return nil, err
+	}
+
+	// Many-to-one fields live on the relation's collection, one-to-many
+	// (and many-to-many) aliases on the related collection as one_field
+	name, _ := url.PathUnescape(collection)
+	related := map[string]string{}
+	for _, rel := range relations.Data {
+		if convert.Str(rel["collection"]) == name {
+			related[convert.Str(rel["field"])] = convert.Str(rel["related_collection"])
+		}
+		if meta, ok := rel["meta"].(map[string]any); ok && convert.Str(rel["related_collection"]) == name {
+			if f := convert.Str(meta["one_field"]); f != "" {
+				related[f] = convert.Str(rel["collection"])
+			}
+		}
+	}
+
+	fields := make([]FieldModel, 0, len(apiResp.Data))
+	for _, data := range apiResp.Data {
+		field := convert.Str(data["field"])
+		meta, _ := data["meta"].(map[string]any)
+
+		special := []attr.Value{}
+		if v, ok := meta["special"].([]any); ok {
+			for _, f := range v {
+				special = append(special, types.StringValue(convert.Str(f)))
+			}
+		}
+		specialList, _ := types.ListValue(types.StringType, special)
+
+		relatedCollection := types.StringNull()
+		if r := related[field]; r != "" {
+			relatedCollection = types.StringValue(r)
+		}
+
+		fields = append(fields, FieldModel{
+			Field:             types.StringValue(field),
+			Type:              convert.StrPtrToType(data["type"]),
+			Interface:         convert.StrPtrToType(meta["interface"]),
+			Hidden:            types.BoolValue(convert.BoolVal(meta["hidden"])),
+			Readonly:          types.BoolValue(convert.BoolVal(meta["readonly"])),
+			Special:           specialList,
+			RelatedCollection: relatedCollection,
+			Meta:              jsonString(data["meta"]),
+			Schema:            jsonString(data["schema"]),
+		})
+	}
+	return fields, nil
+}
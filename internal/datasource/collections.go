@@ -0,0 +1,145 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// CollectionsDataSource implements the directus_collections data source
+type CollectionsDataSource struct{ client *client.Directus }
+
+// CollectionsModel represents the collections data source model
+type CollectionsModel struct {
+	ExcludeSystem  types.Bool        `tfsdk:"exclude_system"`
+	ExcludeFolders types.Bool        `tfsdk:"exclude_folders"`
+	Names          types.List        `tfsdk:"names"`
+	Collections    []CollectionModel `tfsdk:"collections"`
+}
+
+// CollectionModel represents a single collection
+type CollectionModel struct {
+	Collection types.String `tfsdk:"collection"`
+	System     types.Bool   `tfsdk:"system"`
+	Folder     types.Bool   `tfsdk:"folder"`
+	Singleton  types.Bool   `tfsdk:"singleton"`
+	Hidden     types.Bool   `tfsdk:"hidden"`
+	Group      types.String `tfsdk:"group"`
+	Meta       types.String `tfsdk:"meta"`   // JSON string
+	Schema     types.String `tfsdk:"schema"` // JSON string
+}
+
+// NewCollectionsDataSource returns a new collections data source
+func NewCollectionsDataSource() datasource.DataSource { return &CollectionsDataSource{} }
+
+// Metadata returns the data source type name
+func (d *CollectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+// Schema defines the schema for the data source
+func (d *CollectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"exclude_system":  dschema.BoolAttribute{Optional: true, Description: "leave out directus_* system collections"},
+			"exclude_folders": dschema.BoolAttribute{Optional: true, Description: "leave out folders, which group collections but hold no data"},
+			"names": dschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "names of the returned collections",
+			},
+			"collections": dschema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: dschema.NestedAttributeObject{Attributes: collectionAttributes()},
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *CollectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read lists the collections
+func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg CollectionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// /collections isn't paginated
+	apiResp := struct {
+		Data []map[string]any `json:"data"`
+	}{}
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/collections", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	names := []string{}
+	cfg.Collections = []CollectionModel{}
+	for _, data := range apiResp.Data {
+		c := flattenCollection(data)
+		if cfg.ExcludeSystem.ValueBool() && c.System.ValueBool() {
+			continue
+		}
+		if cfg.ExcludeFolders.ValueBool() && c.Folder.ValueBool() {
+			continue
+		}
+		names = append(names, c.Collection.ValueString())
+		cfg.Collections = append(cfg.Collections, c)
+	}
+
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	cfg.Names = nameList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// Helper functions
+
+func collectionAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"collection": dschema.StringAttribute{Computed: true},
+		"system":     dschema.BoolAttribute{Computed: true, Description: "whether this is a directus_* system collection"},
+		"folder":     dschema.BoolAttribute{Computed: true, Description: "whether this is a folder without a database table"},
+		"singleton":  dschema.BoolAttribute{Computed: true},
+		"hidden":     dschema.BoolAttribute{Computed: true},
+		"group":      dschema.StringAttribute{Computed: true, Description: "parent collection or folder"},
+		"meta":       dschema.StringAttribute{Computed: true, Description: "JSON string of the collection meta"},
+		"schema":     dschema.StringAttribute{Computed: true, Description: "JSON string of the database schema"},
+	}
+}
+
+// helper: map API data into a collection
+func flattenCollection(data map[string]any) CollectionModel {
+	name := convert.Str(data["collection"])
+	meta, _ := data["meta"].(map[string]any)
+	return CollectionModel{
+		Collection: types.StringValue(name),
+		System:     types.BoolValue(strings.HasPrefix(name, "directus_")),
+		Folder:     types.BoolValue(data["schema"] == nil),
+		Singleton:  types.BoolValue(convert.BoolVal(meta["singleton"])),
+		Hidden:     types.BoolValue(convert.BoolVal(meta["hidden"])),
+		Group:      convert.StrPtrToType(meta["group"]),
+		Meta:       jsonString(data["meta"]),
+		Schema:     jsonString(data["schema"]),
+	}
+}
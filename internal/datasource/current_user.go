@@ -0,0 +1,102 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// CurrentUserDataSource implements the directus_current_user data source
+type CurrentUserDataSource struct{ client *client.Directus }
+
+// CurrentUserModel represents the current user data source model
+type CurrentUserModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Policies    types.Set    `tfsdk:"policies"`
+	AdminAccess types.Bool   `tfsdk:"admin_access"`
+	AppAccess   types.Bool   `tfsdk:"app_access"`
+}
+
+// NewCurrentUserDataSource returns a new current user data source
+func NewCurrentUserDataSource() datasource.DataSource { return &CurrentUserDataSource{} }
+
+// Metadata returns the data source type name
+func (d *CurrentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source
+func (d *CurrentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "The user the provider token belongs to",
+		Attributes: map[string]dschema.Attribute{
+			"id":    dschema.StringAttribute{Computed: true},
+			"email": dschema.StringAttribute{Computed: true},
+			"role":  dschema.StringAttribute{Computed: true},
+			"policies": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the policies attached to the user directly",
+			},
+			"admin_access": dschema.BoolAttribute{Computed: true, Description: "whether any of the user's policies grants admin access"},
+			"app_access":   dschema.BoolAttribute{Computed: true, Description: "whether any of the user's policies grants app access"},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *CurrentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read reads the authenticated user and their effective access
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CurrentUserModel
+
+	user := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/users/me?fields=id,email,role,policies.policy", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := client.ParseResponse(httpResp, &user); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	globals := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	globalsResp, err := d.client.Request(ctx, http.MethodGet, "/policies/me/globals", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer globalsResp.Body.Close()
+	if err := client.ParseResponse(globalsResp, &globals); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(convert.Str(user.Data["id"]))
+	state.Email = convert.StrPtrToType(user.Data["email"])
+	state.Role = convert.StrPtrToType(user.Data["role"])
+	state.Policies = stringSet(pluck(user.Data["policies"], "policy"))
+	state.AdminAccess = types.BoolValue(convert.BoolVal(globals.Data["admin_access"]))
+	state.AppAccess = types.BoolValue(convert.BoolVal(globals.Data["app_access"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,81 @@
+package datasource
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+// FieldsDataSource implements the directus_fields data source
+type FieldsDataSource struct{ client *client.Directus }
+
+// FieldsModel represents the fields data source model
+type FieldsModel struct {
+	Collection types.String `tfsdk:"collection"`
+	Names      types.List   `tfsdk:"names"`
+	Fields     []FieldModel `tfsdk:"fields"`
+}
+
+// NewFieldsDataSource returns a new fields data source
+func NewFieldsDataSource() datasource.DataSource { return &FieldsDataSource{} }
+
+// Metadata returns the data source type name
+func (d *FieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fields"
+}
+
+// Schema defines the schema for the data source
+func (d *FieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"collection": dschema.StringAttribute{Required: true},
+			"names": dschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "names of the collection's fields",
+			},
+			"fields": dschema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: dschema.NestedAttributeObject{Attributes: fieldAttributes()},
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *FieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read lists the fields of the collection
+func (d *FieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg FieldsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := readFields(ctx, d.client, url.PathEscape(cfg.Collection.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Field.ValueString())
+	}
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+
+	cfg.Names = nameList
+	cfg.Fields = fields
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -0,0 +1,118 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ datasource.DataSourceWithValidateConfig = &FileDataSource{}
+
+// FileDataSource implements the directus_file data source
+type FileDataSource struct{ client *client.Directus }
+
+// FileLookupModel represents the file data source model
+type FileLookupModel struct {
+	FileModel
+	Filter types.String `tfsdk:"filter"` // JSON string
+}
+
+// NewFileDataSource returns a new file data source
+func NewFileDataSource() datasource.DataSource { return &FileDataSource{} }
+
+// Metadata returns the data source type name
+func (d *FileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema defines the schema for the data source
+func (d *FileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := fileAttributes()
+	attrs["id"] = dschema.StringAttribute{Optional: true, Computed: true}
+	attrs["title"] = dschema.StringAttribute{Optional: true, Computed: true}
+	attrs["filename_download"] = dschema.StringAttribute{Optional: true, Computed: true}
+	attrs["folder"] = dschema.StringAttribute{Optional: true, Computed: true, Description: "ID of the folder holding the file"}
+	attrs["type"] = dschema.StringAttribute{Optional: true, Computed: true, Description: "MIME type, e.g. image/png"}
+	attrs["filter"] = dschema.StringAttribute{Optional: true, Description: "JSON string of a Directus filter"}
+	resp.Schema = dschema.Schema{
+		Description: "Looks up a single file by ID or by criteria matching exactly one file",
+		Attributes:  attrs,
+	}
+}
+
+// Configure configures the data source
+func (d *FileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures the file is looked up by at least one criterion
+func (d *FileDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg FileLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []types.String{cfg.ID, cfg.Title, cfg.FilenameDownload, cfg.Folder, cfg.Type, cfg.Filter} {
+		if !v.IsNull() {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("id"), "invalid lookup",
+		"One of `id`, `title`, `filename_download`, `folder`, `type` or `filter` must be set.")
+}
+
+// Read looks up the file
+func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg FileLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data map[string]any
+	if !cfg.ID.IsNull() {
+		apiResp := struct {
+			Data map[string]any `json:"data"`
+		}{}
+		httpResp, err := d.client.Request(ctx, http.MethodGet, "/files/"+cfg.ID.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		defer httpResp.Body.Close()
+		if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		data = apiResp.Data
+	} else {
+		query, err := fileQuery(cfg.Title, cfg.FilenameDownload, cfg.Folder, cfg.Type, cfg.Filter)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid filter", err.Error())
+			return
+		}
+		items, err := d.client.GetAll(ctx, "/files", query)
+		if err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		if len(items) != 1 {
+			resp.Diagnostics.AddError("file lookup failed",
+				fmt.Sprintf("expected exactly one matching file, found %d; narrow the criteria or use directus_files", len(items)))
+			return
+		}
+		data = items[0]
+	}
+
+	cfg.FileModel = flattenFile(d.client.BaseURL(), data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
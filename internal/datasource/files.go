@@ -0,0 +1,214 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// FilesDataSource implements the directus_files data source
+type FilesDataSource struct{ client *client.Directus }
+
+// FilesModel represents the files data source model
+type FilesModel struct {
+	Title            types.String `tfsdk:"title"`
+	FilenameDownload types.String `tfsdk:"filename_download"`
+	Folder           types.String `tfsdk:"folder"`
+	Type             types.String `tfsdk:"type"`
+	Filter           types.String `tfsdk:"filter"` // JSON string
+	IDs              types.List   `tfsdk:"ids"`
+	Files            []FileModel  `tfsdk:"files"`
+}
+
+// FileModel represents a single file, shaped like the directus_file resource
+// plus its asset URLs
+type FileModel struct {
+	ID               types.String `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+	Type             types.String `tfsdk:"type"`
+	FilenameDisk     types.String `tfsdk:"filename_disk"`
+	FilenameDownload types.String `tfsdk:"filename_download"`
+	Storage          types.String `tfsdk:"storage"`
+	Folder           types.String `tfsdk:"folder"`
+	UploadedBy       types.String `tfsdk:"uploaded_by"`
+	UploadedOn       types.String `tfsdk:"uploaded_on"`
+	ModifiedBy       types.String `tfsdk:"modified_by"`
+	ModifiedOn       types.String `tfsdk:"modified_on"`
+	Metadata         types.String `tfsdk:"metadata"`
+	Checksum         types.String `tfsdk:"checksum"`
+	Width            types.Int64  `tfsdk:"width"`
+	Height           types.Int64  `tfsdk:"height"`
+	Filesize         types.Int64  `tfsdk:"filesize"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	AssetURL         types.String `tfsdk:"asset_url"`
+	DownloadURL      types.String `tfsdk:"download_url"`
+}
+
+// NewFilesDataSource returns a new files data source
+func NewFilesDataSource() datasource.DataSource { return &FilesDataSource{} }
+
+// Metadata returns the data source type name
+func (d *FilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+// Schema defines the schema for the data source
+func (d *FilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"title":             dschema.StringAttribute{Optional: true},
+			"filename_download": dschema.StringAttribute{Optional: true},
+			"folder":            dschema.StringAttribute{Optional: true, Description: "ID of the folder holding the files"},
+			"type":              dschema.StringAttribute{Optional: true, Description: "MIME type, e.g. image/png"},
+			"filter":            dschema.StringAttribute{Optional: true, Description: "JSON string of a Directus filter"},
+			"ids": dschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the matching files",
+			},
+			"files": dschema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: dschema.NestedAttributeObject{Attributes: fileAttributes()},
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *FilesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read lists the matching files
+func (d *FilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg FilesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := fileQuery(cfg.Title, cfg.FilenameDownload, cfg.Folder, cfg.Type, cfg.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid filter", err.Error())
+		return
+	}
+	items, err := d.client.GetAll(ctx, "/files", query)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	ids := make([]string, 0, len(items))
+	cfg.Files = make([]FileModel, 0, len(items))
+	for _, data := range items {
+		f := flattenFile(d.client.BaseURL(), data)
+		ids = append(ids, f.ID.ValueString())
+		cfg.Files = append(cfg.Files, f)
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// Helper functions
+
+func fileAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"id":                dschema.StringAttribute{Computed: true},
+		"title":             dschema.StringAttribute{Computed: true},
+		"description":       dschema.StringAttribute{Computed: true},
+		"type":              dschema.StringAttribute{Computed: true},
+		"filename_disk":     dschema.StringAttribute{Computed: true},
+		"filename_download": dschema.StringAttribute{Computed: true},
+		"storage":           dschema.StringAttribute{Computed: true},
+		"folder":            dschema.StringAttribute{Computed: true},
+		"uploaded_by":       dschema.StringAttribute{Computed: true},
+		"uploaded_on":       dschema.StringAttribute{Computed: true},
+		"modified_by":       dschema.StringAttribute{Computed: true},
+		"modified_on":       dschema.StringAttribute{Computed: true},
+		"metadata":          dschema.StringAttribute{Computed: true},
+		"checksum":          dschema.StringAttribute{Computed: true},
+		"width":             dschema.Int64Attribute{Computed: true},
+		"height":            dschema.Int64Attribute{Computed: true},
+		"filesize":          dschema.Int64Attribute{Computed: true},
+		"duration":          dschema.Int64Attribute{Computed: true},
+		"asset_url":         dschema.StringAttribute{Computed: true, Description: "URL serving the file through /assets"},
+		"download_url":      dschema.StringAttribute{Computed: true, Description: "URL serving the file as an attachment"},
+	}
+}
+
+// fileQuery builds the /files query matching every set criterion
+func fileQuery(title, filenameDownload, folder, mimeType, filter types.String) (url.Values, error) {
+	conditions := []any{}
+	for _, c := range []struct {
+		field string
+		value types.String
+	}{
+		{"title", title},
+		{"filename_download", filenameDownload},
+		{"folder", folder},
+		{"type", mimeType},
+	} {
+		if !c.value.IsNull() {
+			conditions = append(conditions, map[string]any{c.field: map[string]any{"_eq": c.value.ValueString()}})
+		}
+	}
+	if s := filter.ValueString(); s != "" {
+		var f any
+		if err := json.Unmarshal([]byte(s), &f); err != nil {
+			return nil, fmt.Errorf("`filter` must be a JSON string: %v", err)
+		}
+		conditions = append(conditions, f)
+	}
+
+	query := url.Values{"sort": {"uploaded_on,id"}}
+	if len(conditions) > 0 {
+		b, err := json.Marshal(map[string]any{"_and": conditions})
+		if err != nil {
+			return nil, err
+		}
+		query.Set("filter", string(b))
+	}
+	return query, nil
+}
+
+// helper: map API data into a file
+func flattenFile(baseURL string, data map[string]any) FileModel {
+	id := convert.Str(data["id"])
+	assetURL := baseURL + "/assets/" + id
+	return FileModel{
+		ID:               types.StringValue(id),
+		Title:            convert.StrPtrToType(data["title"]),
+		Description:      convert.StrPtrToType(data["description"]),
+		Type:             convert.StrPtrToType(data["type"]),
+		FilenameDisk:     convert.StrPtrToType(data["filename_disk"]),
+		FilenameDownload: convert.StrPtrToType(data["filename_download"]),
+		Storage:          convert.StrPtrToType(data["storage"]),
+		Folder:           convert.StrPtrToType(data["folder"]),
+		UploadedBy:       convert.StrPtrToType(data["uploaded_by"]),
+		UploadedOn:       convert.StrPtrToType(data["uploaded_on"]),
+		ModifiedBy:       convert.StrPtrToType(data["modified_by"]),
+		ModifiedOn:       convert.StrPtrToType(data["modified_on"]),
+		Metadata:         convert.StrPtrToType(data["metadata"]),
+		Checksum:         convert.StrPtrToType(data["checksum"]),
+		Width:            convert.Int64PtrToType(data["width"]),
+		Height:           convert.Int64PtrToType(data["height"]),
+		Filesize:         convert.Int64PtrToType(data["filesize"]),
+		Duration:         convert.Int64PtrToType(data["duration"]),
+		AssetURL:         types.StringValue(assetURL),
+		DownloadURL:      types.StringValue(assetURL + "?download"),
+	}
+}
@@ -0,0 +1,79 @@
+package datasource
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFileQuery(t *testing.T) {
+	null := types.StringNull()
+	tests := []struct {
+		name                                          string
+		title, filenameDownload, folder, mime, filter types.String
+		want                                          url.Values
+		wantErr                                       string
+	}{
+		{
+			name:  "no criteria",
+			title: null, filenameDownload: null, folder: null, mime: null, filter: null,
+			want: url.Values{"sort": {"uploaded_on,id"}},
+		},
+		{
+			name:  "single criterion",
+			title: types.StringValue("Logo"), filenameDownload: null, folder: null, mime: null, filter: null,
+			want: url.Values{
+				"sort":   {"uploaded_on,id"},
+				"filter": {`{"_and":[{"title":{"_eq":"Logo"}}]}`},
+			},
+		},
+		{
+			name:             "criteria and filter combined",
+			title:            null,
+			filenameDownload: types.StringValue("logo.png"),
+			folder:           types.StringValue("f1"),
+			mime:             types.StringValue("image/png"),
+			filter:           types.StringValue(`{"filesize":{"_lt":1000}}`),
+			want: url.Values{
+				"sort": {"uploaded_on,id"},
+				"filter": {`{"_and":[{"filename_download":{"_eq":"logo.png"}},{"folder":{"_eq":"f1"}},` +
+					`{"type":{"_eq":"image/png"}},{"filesize":{"_lt":1000}}]}`},
+			},
+		},
+		{
+			name:  "empty string is a criterion",
+			title: types.StringValue(""), filenameDownload: null, folder: null, mime: null, filter: null,
+			want: url.Values{
+				"sort":   {"uploaded_on,id"},
+				"filter": {`{"_and":[{"title":{"_eq":""}}]}`},
+			},
+		},
+		{
+			name:  "invalid filter",
+			title: null, filenameDownload: null, folder: null, mime: null,
+			filter:  types.StringValue(`{"filesize":`),
+			wantErr: "`filter` must be a JSON string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fileQuery(tt.title, tt.filenameDownload, tt.folder, tt.mime, tt.filter)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("query = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+var _ datasource.DataSourceWithValidateConfig = &FolderDataSource{}
+
+// FolderDataSource implements the directus_folder data source
+type FolderDataSource struct{ client *client.Directus }
+
+// FolderModel represents the folder data source model
+type FolderModel struct {
+	ID     types.String `tfsdk:"id"`
+	Path   types.String `tfsdk:"path"`
+	Name   types.String `tfsdk:"name"`
+	Parent types.String `tfsdk:"parent"`
+}
+
+// NewFolderDataSource returns a new folder data source
+func NewFolderDataSource() datasource.DataSource { return &FolderDataSource{} }
+
+// Metadata returns the data source type name
+func (d *FolderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+// Schema defines the schema for the data source
+func (d *FolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"id":     dschema.StringAttribute{Optional: true, Computed: true, Description: "ID of the folder; conflicts with path and name"},
+			"path":   dschema.StringAttribute{Optional: true, Computed: true, Description: "slash separated path from the root, e.g. uploads/avatars"},
+			"name":   dschema.StringAttribute{Optional: true, Computed: true, Description: "name of the folder, optionally narrowed down by parent"},
+			"parent": dschema.StringAttribute{Optional: true, Computed: true, Description: "ID of the parent folder"},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *FolderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures the folder is looked up by exactly one of id, path or name
+func (d *FolderDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg FolderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.ID.IsUnknown() || cfg.Path.IsUnknown() || cfg.Name.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, v := range []types.String{cfg.ID, cfg.Path, cfg.Name} {
+		if !v.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "invalid lookup", "Exactly one of `id`, `path` or `name` must be set.")
+	}
+	if !cfg.Parent.IsNull() && cfg.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("parent"), "invalid lookup", "`parent` can only be used together with `name`.")
+	}
+}
+
+// Read resolves the folder
+func (d *FolderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg FolderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.client.GetAll(ctx, "/folders", url.Values{"fields": {"id,name,parent"}})
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	folders := make(map[string]map[string]any, len(items))
+	for _, f := range items {
+		folders[convert.Str(f["id"])] = f
+	}
+
+	var id string
+	switch {
+	case !cfg.ID.IsNull():
+		id = cfg.ID.ValueString()
+		if _, ok := folders[id]; !ok {
+			err = fmt.Errorf("no folder with id %q", id)
+		}
+	case !cfg.Path.IsNull():
+		id, err = resolveFolderPath(items, cfg.Path.ValueString())
+	default:
+		var matches []string
+		for _, f := range items {
+			if convert.Str(f["name"]) != cfg.Name.ValueString() {
+				continue
+			}
+			if !cfg.Parent.IsNull() && convert.Str(f["parent"]) != cfg.Parent.ValueString() {
+				continue
+			}
+			matches = append(matches, convert.Str(f["id"]))
+		}
+		id, err = singleFolder(matches, cfg.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("folder lookup failed", err.Error())
+		return
+	}
+
+	// Build the full path by walking up the parents
+	segments := []string{}
+	seen := map[string]struct{}{}
+	for cur := id; cur != ""; cur = convert.Str(folders[cur]["parent"]) {
+		if _, ok := seen[cur]; ok {
+			resp.Diagnostics.AddError("folder lookup failed", fmt.Sprintf("folder %q has cyclic parents", id))
+			return
+		}
+		seen[cur] = struct{}{}
+		segments = append([]string{convert.Str(folders[cur]["name"])}, segments...)
+	}
+
+	folder := folders[id]
+	cfg.ID = types.StringValue(id)
+	cfg.Name = types.StringValue(convert.Str(folder["name"]))
+	cfg.Parent = convert.StrPtrToType(folder["parent"])
+	cfg.Path = types.StringValue(strings.Join(segments, "/"))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// Helper functions
+
+// resolveFolderPath walks a slash separated path down from the root folders
+func resolveFolderPath(folders []map[string]any, p string) (string, error) {
+	parent := ""
+	for _, name := range strings.Split(p, "/") {
+		if name == "" {
+			continue
+		}
+		var matches []string
+		for _, f := range folders {
+			if convert.Str(f["name"]) == name && convert.Str(f["parent"]) == parent {
+				matches = append(matches, convert.Str(f["id"]))
+			}
+		}
+		id, err := singleFolder(matches, name)
+		if err != nil {
+			return "", fmt.Errorf("resolving %q: %v", p, err)
+		}
+		parent = id
+	}
+	if parent == "" {
+		return "", fmt.Errorf("path %q doesn't name a folder", p)
+	}
+	return parent, nil
+}
+
+func singleFolder(matches []string, name string) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no folder named %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d folders named %q, narrow it down with a parent or path", len(matches), name)
+	}
+}
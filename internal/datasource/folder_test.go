@@ -0,0 +1,51 @@
+package datasource
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolveFolderPath(t *testing.T) {
+	folders := []map[string]any{
+		{"id": "1", "name": "assets", "parent": nil},
+		{"id": "2", "name": "images", "parent": "1"},
+		{"id": "3", "name": "logos", "parent": "2"},
+		{"id": "4", "name": "images", "parent": nil},
+		{"id": "5", "name": "dup", "parent": "1"},
+		{"id": "6", "name": "dup", "parent": "1"},
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "root folder", path: "assets", want: "1"},
+		{name: "nested folder", path: "assets/images/logos", want: "3"},
+		{name: "same name under another parent", path: "images", want: "4"},
+		{name: "extra slashes", path: "/assets//images/", want: "2"},
+		{name: "missing folder", path: "assets/videos", wantErr: `no folder named "videos"`},
+		{name: "not a root folder", path: "logos", wantErr: `no folder named "logos"`},
+		{name: "ambiguous", path: "assets/dup", wantErr: `2 folders named "dup"`},
+		{name: "empty path", path: "/", wantErr: "doesn't name a folder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveFolderPath(folders, tt.path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveFolderPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
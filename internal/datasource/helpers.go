@@ -0,0 +1,105 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// lookupID returns the ID of the single item at path whose field equals
+// value, failing when there is no match or more than one.
+func lookupID(ctx context.Context, c *client.Directus, path, field, value string) (string, error) {
+	filter, err := json.Marshal(map[string]any{field: map[string]any{"_eq": value}})
+	if err != nil {
+		return "", err
+	}
+	items, err := c.GetAll(ctx, path, url.Values{
+		"filter": {string(filter)},
+		"fields": {"id"},
+	})
+	if err != nil {
+		return "", err
+	}
+	switch len(items) {
+	case 0:
+		return "", fmt.Errorf("no match for %s %q in %s", field, value, path)
+	case 1:
+		return convert.Str(items[0]["id"]), nil
+	default:
+		return "", fmt.Errorf("%d matches for %s %q in %s, use the id instead", len(items), field, value, path)
+	}
+}
+
+// relatedValues returns field from every item at path whose match field
+// equals value. Listing them separately avoids the relation limit that
+// applies to nested o2m fields.
+func relatedValues(ctx context.Context, c *client.Directus, path, match, value, field string) ([]any, error) {
+	filter, err := json.Marshal(map[string]any{match: map[string]any{"_eq": value}})
+	if err != nil {
+		return nil, err
+	}
+	items, err := c.GetAll(ctx, path, url.Values{
+		"filter": {string(filter)},
+		"fields": {field},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		out = append(out, item[field])
+	}
+	return out, nil
+}
+
+// jsonString encodes a JSON value returned by the API as a normalized
+// string, with object keys sorted
+func jsonString(v any) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(b))
+}
+
+// stringSet converts an array of IDs returned by the API into a set,
+// skipping empty and duplicate entries
+func stringSet(v any) types.Set {
+	elems := []attr.Value{}
+	seen := map[string]struct{}{}
+	list, _ := v.([]any)
+	for _, e := range list {
+		s := convert.Str(e)
+		if s == "" {
+			continue
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		elems = append(elems, types.StringValue(s))
+	}
+	set, _ := types.SetValue(types.StringType, elems)
+	return set
+}
+
+// pluck returns field from every object of an array returned by the API
+func pluck(v any, field string) []any {
+	list, _ := v.([]any)
+	out := make([]any, 0, len(list))
+	for _, e := range list {
+		if m, ok := e.(map[string]any); ok {
+			out = append(out, m[field])
+		}
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// PermissionsDataSource implements the directus_permissions data source
+type PermissionsDataSource struct{ client *client.Directus }
+
+// PermissionsModel represents the permissions data source model
+type PermissionsModel struct {
+	Policy      types.String      `tfsdk:"policy"`
+	Collection  types.String      `tfsdk:"collection"`
+	Action      types.String      `tfsdk:"action"`
+	Permissions []PermissionModel `tfsdk:"permissions"`
+}
+
+// PermissionModel represents a single permission, shaped like the
+// directus_permission resource
+type PermissionModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Collection  types.String `tfsdk:"collection"`
+	Action      types.String `tfsdk:"action"`
+	Permissions types.String `tfsdk:"permissions"` // JSON string
+	Validation  types.String `tfsdk:"validation"`  // JSON string
+	Fields      types.List   `tfsdk:"fields"`
+	Policy      types.String `tfsdk:"policy"`
+	Presets     types.String `tfsdk:"presets"` // JSON string
+	System      types.Bool   `tfsdk:"system"`
+}
+
+// NewPermissionsDataSource returns a new permissions data source
+func NewPermissionsDataSource() datasource.DataSource { return &PermissionsDataSource{} }
+
+// Metadata returns the data source type name
+func (d *PermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+// Schema defines the schema for the data source
+func (d *PermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"policy":     dschema.StringAttribute{Optional: true, Description: "only return permissions of this policy"},
+			"collection": dschema.StringAttribute{Optional: true, Description: "only return permissions on this collection"},
+			"action":     dschema.StringAttribute{Optional: true, Description: "only return permissions for this action, e.g. read"},
+			"permissions": dschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"id":          dschema.Int64Attribute{Computed: true},
+						"collection":  dschema.StringAttribute{Computed: true},
+						"action":      dschema.StringAttribute{Computed: true},
+						"permissions": dschema.StringAttribute{Computed: true, Description: "JSON string of permissions filter"},
+						"validation":  dschema.StringAttribute{Computed: true, Description: "JSON string of validation rules"},
+						"presets":     dschema.StringAttribute{Computed: true, Description: "JSON string of presets"},
+						"fields":      dschema.ListAttribute{Computed: true, ElementType: types.StringType},
+						"policy":      dschema.StringAttribute{Computed: true},
+						"system":      dschema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *PermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read lists the matching permissions
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg PermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conditions := []any{}
+	for field, v := range map[string]types.String{
+		"policy":     cfg.Policy,
+		"collection": cfg.Collection,
+		"action":     cfg.Action,
+	} {
+		if !v.IsNull() {
+			conditions = append(conditions, map[string]any{field: map[string]any{"_eq": v.ValueString()}})
+		}
+	}
+
+	query := url.Values{"sort": {"id"}}
+	if len(conditions) > 0 {
+		filter, err := json.Marshal(map[string]any{"_and": conditions})
+		if err != nil {
+			resp.Diagnostics.AddError("invalid filter", err.Error())
+			return
+		}
+		query.Set("filter", string(filter))
+	}
+
+	items, err := d.client.GetAll(ctx, "/permissions", query)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	cfg.Permissions = make([]PermissionModel, 0, len(items))
+	for _, data := range items {
+		fields := []attr.Value{}
+		if v, ok := data["fields"].([]any); ok {
+			for _, f := range v {
+				fields = append(fields, types.StringValue(convert.Str(f)))
+			}
+		}
+		fieldList, _ := types.ListValue(types.StringType, fields)
+
+		cfg.Permissions = append(cfg.Permissions, PermissionModel{
+			ID:          convert.Int64PtrToType(data["id"]),
+			Collection:  types.StringValue(convert.Str(data["collection"])),
+			Action:      types.StringValue(convert.Str(data["action"])),
+			Permissions: jsonString(data["permissions"]),
+			Validation:  jsonString(data["validation"]),
+			Fields:      fieldList,
+			Policy:      convert.StrPtrToType(data["policy"]),
+			Presets:     jsonString(data["presets"]),
+			System:      types.BoolValue(convert.BoolVal(data["system"])),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
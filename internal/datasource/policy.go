@@ -0,0 +1,265 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+var _ datasource.DataSourceWithValidateConfig = &PolicyDataSource{}
+
+// PolicyDataSource implements the directus_policy data source
+type PolicyDataSource struct{ client *client.Directus }
+
+// PolicyModel represents the policy data source model
+type PolicyModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Builtin     types.String `tfsdk:"builtin"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	IPAccess    types.List   `tfsdk:"ip_access"`
+	EnforceTFA  types.Bool   `tfsdk:"enforce_tfa"`
+	AdminAccess types.Bool   `tfsdk:"admin_access"`
+	AppAccess   types.Bool   `tfsdk:"app_access"`
+	Roles       types.Set    `tfsdk:"roles"`
+	Users       types.Set    `tfsdk:"users"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// NewPolicyDataSource returns a new policy data source
+func NewPolicyDataSource() datasource.DataSource { return &PolicyDataSource{} }
+
+// Metadata returns the data source type name
+func (d *PolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// Schema defines the schema for the data source
+func (d *PolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"id":   dschema.StringAttribute{Optional: true, Computed: true, Description: "ID of the policy; conflicts with name and builtin"},
+			"name": dschema.StringAttribute{Optional: true, Computed: true, Description: "name of the policy; conflicts with id and builtin"},
+			"builtin": dschema.StringAttribute{
+				Optional:    true,
+				Description: "built-in policy to look up: public or admin; conflicts with id and name",
+			},
+			"icon":        dschema.StringAttribute{Computed: true},
+			"description": dschema.StringAttribute{Computed: true},
+			"ip_access": dschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"enforce_tfa":  dschema.BoolAttribute{Computed: true},
+			"admin_access": dschema.BoolAttribute{Computed: true},
+			"app_access":   dschema.BoolAttribute{Computed: true},
+			"roles": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the roles the policy is attached to",
+			},
+			"users": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the users the policy is attached to directly",
+			},
+			"permissions": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the permissions granted by the policy",
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *PolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures the policy is looked up by exactly one selector
+func (d *PolicyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg PolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.ID.IsUnknown() || cfg.Name.IsUnknown() || cfg.Builtin.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, v := range []types.String{cfg.ID, cfg.Name, cfg.Builtin} {
+		if !v.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "invalid lookup", "Exactly one of `id`, `name` or `builtin` must be set.")
+	}
+	if b := cfg.Builtin.ValueString(); !cfg.Builtin.IsNull() && b != "public" && b != "admin" {
+		resp.Diagnostics.AddAttributeError(path.Root("builtin"), "invalid builtin", fmt.Sprintf("Expected public or admin, got: %q", b))
+	}
+}
+
+// Read looks up the policy
+func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg PolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id string
+	var err error
+	switch {
+	case !cfg.ID.IsNull():
+		id = cfg.ID.ValueString()
+	case !cfg.Name.IsNull():
+		id, err = lookupID(ctx, d.client, "/policies", "name", cfg.Name.ValueString())
+	case cfg.Builtin.ValueString() == "public":
+		id, err = d.publicPolicy(ctx)
+	default:
+		id, err = d.adminPolicy(ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("policy lookup failed", err.Error())
+		return
+	}
+
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/policies/"+id+"?fields=*", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	data := apiResp.Data
+	cfg.ID = types.StringValue(convert.Str(data["id"]))
+	cfg.Name = types.StringValue(convert.Str(data["name"]))
+	cfg.Icon = convert.StrPtrToType(data["icon"])
+	cfg.Description = convert.StrPtrToType(data["description"])
+	cfg.EnforceTFA = convert.BoolPtrToType(data["enforce_tfa"])
+	cfg.AdminAccess = convert.BoolPtrToType(data["admin_access"])
+	cfg.AppAccess = convert.BoolPtrToType(data["app_access"])
+
+	ipAccess := []attr.Value{}
+	if v, ok := data["ip_access"].([]any); ok {
+		for _, ip := range v {
+			ipAccess = append(ipAccess, types.StringValue(convert.Str(ip)))
+		}
+	}
+	cfg.IPAccess, _ = types.ListValue(types.StringType, ipAccess)
+
+	roles, err := relatedValues(ctx, d.client, "/access", "policy", id, "role")
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	cfg.Roles = stringSet(roles)
+
+	users, err := relatedValues(ctx, d.client, "/access", "policy", id, "user")
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	cfg.Users = stringSet(users)
+
+	ids, err := relatedValues(ctx, d.client, "/permissions", "policy", id, "id")
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	permissions := []attr.Value{}
+	for _, p := range ids {
+		if v := convert.Int64PtrToType(p); !v.IsNull() {
+			permissions = append(permissions, v)
+		}
+	}
+	cfg.Permissions, _ = types.SetValue(types.Int64Type, permissions)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// Helper functions
+
+// publicPolicy finds the policy attached to neither a role nor a user, which
+// is how Directus represents the Public policy
+func (d *PolicyDataSource) publicPolicy(ctx context.Context) (string, error) {
+	filter, err := json.Marshal(map[string]any{"_and": []any{
+		map[string]any{"role": map[string]any{"_null": true}},
+		map[string]any{"user": map[string]any{"_null": true}},
+	}})
+	if err != nil {
+		return "", err
+	}
+	access, err := d.client.GetAll(ctx, "/access", url.Values{
+		"filter": {string(filter)},
+		"fields": {"policy"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ids := map[string]struct{}{}
+	for _, a := range access {
+		if id := convert.Str(a["policy"]); id != "" {
+			ids[id] = struct{}{}
+		}
+	}
+	if len(ids) != 1 {
+		return "", fmt.Errorf("expected a single public policy, found %d", len(ids))
+	}
+	for id := range ids {
+		return id, nil
+	}
+	return "", nil
+}
+
+// adminPolicy finds the policy granting admin access, preferring the one
+// named Administrator when several do
+func (d *PolicyDataSource) adminPolicy(ctx context.Context) (string, error) {
+	filter, err := json.Marshal(map[string]any{"admin_access": map[string]any{"_eq": true}})
+	if err != nil {
+		return "", err
+	}
+	policies, err := d.client.GetAll(ctx, "/policies", url.Values{
+		"filter": {string(filter)},
+		"fields": {"id,name"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(policies) == 1 {
+		return convert.Str(policies[0]["id"]), nil
+	}
+	for _, p := range policies {
+		if convert.Str(p["name"]) == "Administrator" {
+			return convert.Str(p["id"]), nil
+		}
+	}
+	return "", fmt.Errorf("expected a single admin policy, found %d; look it up by name instead", len(policies))
+}
@@ -0,0 +1,182 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+var _ datasource.DataSourceWithValidateConfig = &RoleDataSource{}
+
+// RoleDataSource implements the directus_role data source
+type RoleDataSource struct{ client *client.Directus }
+
+// RoleModel represents the role data source model
+type RoleModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Parent      types.String `tfsdk:"parent"`
+	Children    types.Set    `tfsdk:"children"`
+	Policies    types.Set    `tfsdk:"policies"`
+	UserCount   types.Int64  `tfsdk:"user_count"`
+}
+
+// NewRoleDataSource returns a new role data source
+func NewRoleDataSource() datasource.DataSource { return &RoleDataSource{} }
+
+// Metadata returns the data source type name
+func (d *RoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the data source
+func (d *RoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"id":          dschema.StringAttribute{Optional: true, Computed: true, Description: "ID of the role; conflicts with name"},
+			"name":        dschema.StringAttribute{Optional: true, Computed: true, Description: "name of the role; conflicts with id"},
+			"icon":        dschema.StringAttribute{Computed: true},
+			"description": dschema.StringAttribute{Computed: true},
+			"parent":      dschema.StringAttribute{Computed: true, Description: "ID of the parent role"},
+			"children": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the roles nested under this role",
+			},
+			"policies": dschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the policies attached to this role",
+			},
+			"user_count": dschema.Int64Attribute{Computed: true},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *RoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures the role is looked up by exactly one of id or name
+func (d *RoleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg RoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.ID.IsUnknown() || cfg.Name.IsUnknown() {
+		return
+	}
+	if cfg.ID.IsNull() == cfg.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "invalid lookup", "Exactly one of `id` or `name` must be set.")
+	}
+}
+
+// Read looks up the role
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg RoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := cfg.ID.ValueString()
+	if cfg.ID.IsNull() {
+		var err error
+		id, err = lookupID(ctx, d.client, "/roles", "name", cfg.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("role lookup failed", err.Error())
+			return
+		}
+	}
+
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/roles/"+id+"?fields=*", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := client.ParseResponse(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	data := apiResp.Data
+	cfg.ID = types.StringValue(convert.Str(data["id"]))
+	cfg.Name = types.StringValue(convert.Str(data["name"]))
+	cfg.Icon = convert.StrPtrToType(data["icon"])
+	cfg.Description = convert.StrPtrToType(data["description"])
+	cfg.Parent = convert.StrPtrToType(data["parent"])
+
+	// children and policies are o2m fields capped by the relation limit, so list them separately
+	children, err := relatedValues(ctx, d.client, "/roles", "parent", id, "id")
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	cfg.Children = stringSet(children)
+
+	policies, err := relatedValues(ctx, d.client, "/access", "role", id, "policy")
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	cfg.Policies = stringSet(policies)
+
+	// users is capped the same way, and only its size is needed
+	count, err := d.userCount(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	cfg.UserCount = count
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// userCount counts the users of the role with an aggregate query
+func (d *RoleDataSource) userCount(ctx context.Context, id string) (types.Int64, error) {
+	filter, err := json.Marshal(map[string]any{"role": map[string]any{"_eq": id}})
+	if err != nil {
+		return types.Int64Null(), err
+	}
+	q := url.Values{
+		"aggregate[count]": {"*"},
+		"filter":           {string(filter)},
+	}
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/users?"+q.Encode(), nil)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data []map[string]any `json:"data"`
+	}{}
+	if err := client.ParseResponseNumbers(httpResp, &apiResp); err != nil {
+		return types.Int64Null(), err
+	}
+	if len(apiResp.Data) == 0 {
+		return types.Int64Value(0), nil
+	}
+	// depending on the database the count comes back as a number or a string
+	return convert.Int64PtrToType(apiResp.Data[0]["count"]), nil
+}
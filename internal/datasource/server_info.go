@@ -0,0 +1,168 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// ServerInfoDataSource implements the directus_server_info data source
+type ServerInfoDataSource struct{ client *client.Directus }
+
+// ServerInfoModel represents the server info data source model
+type ServerInfoModel struct {
+	Version                 types.String `tfsdk:"version"`
+	VersionMajor            types.Int64  `tfsdk:"version_major"`
+	VersionMinor            types.Int64  `tfsdk:"version_minor"`
+	VersionPatch            types.Int64  `tfsdk:"version_patch"`
+	ProjectName             types.String `tfsdk:"project_name"`
+	ProjectDescriptor       types.String `tfsdk:"project_descriptor"`
+	ProjectColor            types.String `tfsdk:"project_color"`
+	DefaultLanguage         types.String `tfsdk:"default_language"`
+	RateLimitEnabled        types.Bool   `tfsdk:"rate_limit_enabled"`
+	RateLimitPoints         types.Int64  `tfsdk:"rate_limit_points"`
+	RateLimitDuration       types.Int64  `tfsdk:"rate_limit_duration"`
+	WebsocketEnabled        types.Bool   `tfsdk:"websocket_enabled"`
+	WebsocketRESTEnabled    types.Bool   `tfsdk:"websocket_rest_enabled"`
+	WebsocketGraphQLEnabled types.Bool   `tfsdk:"websocket_graphql_enabled"`
+	ExtensionsCount         types.Int64  `tfsdk:"extensions_count"`
+	HealthStatus            types.String `tfsdk:"health_status"`
+}
+
+// NewServerInfoDataSource returns a new server info data source
+func NewServerInfoDataSource() datasource.DataSource { return &ServerInfoDataSource{} }
+
+// Metadata returns the data source type name
+func (d *ServerInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+// Schema defines the schema for the data source
+func (d *ServerInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"version":                   dschema.StringAttribute{Computed: true, Description: "Directus version, e.g. 11.2.1"},
+			"version_major":             dschema.Int64Attribute{Computed: true},
+			"version_minor":             dschema.Int64Attribute{Computed: true},
+			"version_patch":             dschema.Int64Attribute{Computed: true},
+			"project_name":              dschema.StringAttribute{Computed: true},
+			"project_descriptor":        dschema.StringAttribute{Computed: true},
+			"project_color":             dschema.StringAttribute{Computed: true},
+			"default_language":          dschema.StringAttribute{Computed: true},
+			"rate_limit_enabled":        dschema.BoolAttribute{Computed: true},
+			"rate_limit_points":         dschema.Int64Attribute{Computed: true, Description: "requests allowed per duration"},
+			"rate_limit_duration":       dschema.Int64Attribute{Computed: true, Description: "rate limit window in seconds"},
+			"websocket_enabled":         dschema.BoolAttribute{Computed: true},
+			"websocket_rest_enabled":    dschema.BoolAttribute{Computed: true},
+			"websocket_graphql_enabled": dschema.BoolAttribute{Computed: true},
+			"extensions_count":          dschema.Int64Attribute{Computed: true},
+			"health_status":             dschema.StringAttribute{Computed: true, Description: "one of ok, warn, error"},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *ServerInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read reads the server info, health and installed extensions
+func (d *ServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ServerInfoModel
+
+	info := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := d.client.Request(ctx, http.MethodGet, "/server/info", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := client.ParseResponse(httpResp, &info); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	data := info.Data
+	state.Version = convert.StrPtrToType(data["version"])
+	state.VersionMajor, state.VersionMinor, state.VersionPatch = versionParts(state.Version.ValueString())
+
+	project, _ := data["project"].(map[string]any)
+	state.ProjectName = convert.StrPtrToType(project["project_name"])
+	state.ProjectDescriptor = convert.StrPtrToType(project["project_descriptor"])
+	state.ProjectColor = convert.StrPtrToType(project["project_color"])
+	state.DefaultLanguage = convert.StrPtrToType(project["default_language"])
+
+	// rateLimit and websocket are false when disabled, objects otherwise
+	rateLimit, ok := data["rateLimit"].(map[string]any)
+	state.RateLimitEnabled = types.BoolValue(ok)
+	state.RateLimitPoints = convert.Int64PtrToType(rateLimit["points"])
+	state.RateLimitDuration = convert.Int64PtrToType(rateLimit["duration"])
+
+	websocket, ok := data["websocket"].(map[string]any)
+	state.WebsocketEnabled = types.BoolValue(ok)
+	_, rest := websocket["rest"].(map[string]any)
+	state.WebsocketRESTEnabled = types.BoolValue(rest)
+	_, graphql := websocket["graphql"].(map[string]any)
+	state.WebsocketGraphQLEnabled = types.BoolValue(graphql)
+
+	extensions := struct {
+		Data []any `json:"data"`
+	}{}
+	extResp, err := d.client.Request(ctx, http.MethodGet, "/extensions", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer extResp.Body.Close()
+	if err := client.ParseResponse(extResp, &extensions); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	state.ExtensionsCount = types.Int64Value(int64(len(extensions.Data)))
+
+	// /server/health answers 503 when unhealthy, so decode the body whatever the status
+	health := struct {
+		Status string `json:"status"`
+	}{}
+	healthResp, err := d.client.Request(ctx, http.MethodGet, "/server/health", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer healthResp.Body.Close()
+	if err := json.NewDecoder(healthResp.Body).Decode(&health); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	state.HealthStatus = types.StringValue(health.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper functions
+
+// versionParts splits a version like 11.2.1 or 11.3.0-rc.1 into its numeric
+// parts, leaving missing ones null
+func versionParts(version string) (major, minor, patch types.Int64) {
+	parts := [3]types.Int64{types.Int64Null(), types.Int64Null(), types.Int64Null()}
+	core, _, _ := strings.Cut(version, "-")
+	for i, p := range strings.SplitN(core, ".", 3) {
+		if n, err := strconv.ParseInt(p, 10, 64); err == nil {
+			parts[i] = types.Int64Value(n)
+		}
+	}
+	return parts[0], parts[1], parts[2]
+}
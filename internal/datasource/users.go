@@ -0,0 +1,162 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
+)
+
+// UsersDataSource implements the directus_users data source
+type UsersDataSource struct{ client *client.Directus }
+
+// UsersModel represents the users data source model
+type UsersModel struct {
+	Filter types.String `tfsdk:"filter"` // JSON string
+	Search types.String `tfsdk:"search"`
+	Sort   types.List   `tfsdk:"sort"`
+	Limit  types.Int64  `tfsdk:"limit"`
+	IDs    types.List   `tfsdk:"ids"`
+	Users  []UserModel  `tfsdk:"users"`
+}
+
+// UserModel represents a single user
+type UserModel struct {
+	ID         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	FirstName  types.String `tfsdk:"first_name"`
+	LastName   types.String `tfsdk:"last_name"`
+	Role       types.String `tfsdk:"role"`
+	Status     types.String `tfsdk:"status"`
+	LastAccess types.String `tfsdk:"last_access"`
+}
+
+// NewUsersDataSource returns a new users data source
+func NewUsersDataSource() datasource.DataSource { return &UsersDataSource{} }
+
+// Metadata returns the data source type name
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"filter": dschema.StringAttribute{Optional: true, Description: "JSON string of a Directus filter, e.g. {\"status\":{\"_eq\":\"active\"}}"},
+			"search": dschema.StringAttribute{Optional: true},
+			"sort": dschema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "fields to sort by, prefixed with - for descending order",
+			},
+			"limit": dschema.Int64Attribute{Optional: true, Description: "maximum number of users; all matching users when not set"},
+			"ids": dschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the returned users",
+			},
+			"users": dschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"id":          dschema.StringAttribute{Computed: true},
+						"email":       dschema.StringAttribute{Computed: true},
+						"first_name":  dschema.StringAttribute{Computed: true},
+						"last_name":   dschema.StringAttribute{Computed: true},
+						"role":        dschema.StringAttribute{Computed: true},
+						"status":      dschema.StringAttribute{Computed: true},
+						"last_access": dschema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source
+func (d *UsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Directus)
+}
+
+// Read lists the matching users
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg UsersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{"fields": {"id,email,first_name,last_name,role,status,last_access"}}
+	if s := cfg.Filter.ValueString(); s != "" {
+		if !json.Valid([]byte(s)) {
+			resp.Diagnostics.AddAttributeError(path.Root("filter"), "invalid filter", "`filter` must be a JSON string.")
+			return
+		}
+		query.Set("filter", s)
+	}
+	if s := cfg.Search.ValueString(); s != "" {
+		query.Set("search", s)
+	}
+	if !cfg.Sort.IsNull() {
+		var sort []string
+		resp.Diagnostics.Append(cfg.Sort.ElementsAs(ctx, &sort, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query.Set("sort", strings.Join(stableSort(sort), ","))
+	}
+
+	if !cfg.Limit.IsNull() {
+		query.Set("limit", strconv.FormatInt(cfg.Limit.ValueInt64(), 10))
+	}
+
+	items, err := d.client.GetAll(ctx, "/users", query)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	ids := make([]string, 0, len(items))
+	cfg.Users = make([]UserModel, 0, len(items))
+	for _, data := range items {
+		ids = append(ids, convert.Str(data["id"]))
+		cfg.Users = append(cfg.Users, UserModel{
+			ID:         types.StringValue(convert.Str(data["id"])),
+			Email:      convert.StrPtrToType(data["email"]),
+			FirstName:  convert.StrPtrToType(data["first_name"]),
+			LastName:   convert.StrPtrToType(data["last_name"]),
+			Role:       convert.StrPtrToType(data["role"]),
+			Status:     convert.StrPtrToType(data["status"]),
+			LastAccess: convert.StrPtrToType(data["last_access"]),
+		})
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// stableSort appends id to the sort fields unless they already end with a
+// unique field, so that pages don't overlap when sort values tie
+func stableSort(fields []string) []string {
+	if n := len(fields); n > 0 {
+		switch strings.TrimPrefix(fields[n-1], "-") {
+		case "id", "email":
+			return fields
+		}
+	}
+	return append(fields, "id")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	datasourcepkg "github.com/soft-techies-com/terraform-provider-directus/internal/datasource"
 	resourcepkg "github.com/soft-techies-com/terraform-provider-directus/internal/resource"
 )
 
@@ -99,9 +100,38 @@ func (p *directusProvider) Resources(ctx context.Context) []func() resource.Reso
 		resourcepkg.NewSettingResource,
 		resourcepkg.NewFileResource,
 		resourcepkg.NewPolicyResource,
+		resourcepkg.NewFlowResource,
+		resourcepkg.NewOperationResource,
+		resourcepkg.NewPresetResource,
+		resourcepkg.NewDashboardResource,
+		resourcepkg.NewPanelResource,
+		resourcepkg.NewTranslationResource,
+		resourcepkg.NewTranslationsResource,
+		resourcepkg.NewShareResource,
+		resourcepkg.NewNotificationResource,
+		resourcepkg.NewCommentResource,
+		resourcepkg.NewContentVersionResource,
+		resourcepkg.NewItemResource,
+		resourcepkg.NewSingletonResource,
+		resourcepkg.NewItemsSeedResource,
+		resourcepkg.NewAccessResource,
+		resourcepkg.NewRoleUsersResource,
 	}
 }
 
 func (p *directusProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		datasourcepkg.NewRoleDataSource,
+		datasourcepkg.NewPolicyDataSource,
+		datasourcepkg.NewPermissionsDataSource,
+		datasourcepkg.NewCurrentUserDataSource,
+		datasourcepkg.NewServerInfoDataSource,
+		datasourcepkg.NewCollectionsDataSource,
+		datasourcepkg.NewCollectionDataSource,
+		datasourcepkg.NewFieldsDataSource,
+		datasourcepkg.NewUsersDataSource,
+		datasourcepkg.NewFilesDataSource,
+		datasourcepkg.NewFileDataSource,
+		datasourcepkg.NewFolderDataSource,
+	}
 }
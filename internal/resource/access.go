@@ -0,0 +1,217 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &AccessResource{}
+	_ resource.ResourceWithValidateConfig = &AccessResource{}
+)
+
+// AccessResource implements the directus_access resource, attaching a policy
+// to a role or a user
+type AccessResource struct{ client *client.Directus }
+
+// AccessModel represents the access resource model
+type AccessModel struct {
+	ID     types.String `tfsdk:"id"`
+	Policy types.String `tfsdk:"policy"`
+	Role   types.String `tfsdk:"role"`
+	User   types.String `tfsdk:"user"`
+	Sort   types.Int64  `tfsdk:"sort"`
+}
+
+// NewAccessResource returns a new access resource
+func NewAccessResource() resource.Resource { return &AccessResource{} }
+
+// Metadata returns the resource type name
+func (r *AccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access"
+}
+
+// Schema defines the schema for the resource
+func (r *AccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = rschema.Schema{
+		Description: "Attaches a policy to a role or a user. Don't combine with the `policies` of a " +
+			"directus_role for the same role.",
+		Attributes: map[string]rschema.Attribute{
+			"id":     rschema.StringAttribute{Computed: true},
+			"policy": rschema.StringAttribute{Required: true, PlanModifiers: replace},
+			"role":   rschema.StringAttribute{Optional: true, PlanModifiers: replace, Description: "conflicts with user"},
+			"user":   rschema.StringAttribute{Optional: true, PlanModifiers: replace, Description: "conflicts with role"},
+			"sort":   rschema.Int64Attribute{Optional: true},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *AccessResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures exactly one of role or user is set
+func (r *AccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg AccessModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.Role.IsUnknown() || cfg.User.IsUnknown() {
+		return
+	}
+	if cfg.Role.IsNull() == cfg.User.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "invalid attributes", "Exactly one of `role` or `user` must be set.")
+	}
+}
+
+// Create creates a new access row
+func (r *AccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{
+		"policy": plan.Policy.ValueString(),
+		"role":   nullableStr(plan.Role),
+		"user":   nullableStr(plan.User),
+	}
+	if !plan.Sort.IsNull() {
+		payload["sort"] = plan.Sort.ValueInt64()
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/access", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the access row
+func (r *AccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/access/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the sort order; everything else forces a replacement
+func (r *AccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccessModel
+	var state AccessModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	payload := map[string]any{"sort": nil}
+	if !plan.Sort.IsNull() {
+		payload["sort"] = plan.Sort.ValueInt64()
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/access/"+plan.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete detaches the policy
+func (r *AccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/access/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_access
+func (r *AccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// helper: map API data into the model
+func (r *AccessResource) readIntoState(am *AccessModel, data map[string]any) {
+	am.ID = types.StringValue(str(data["id"]))
+	am.Policy = types.StringValue(str(data["policy"]))
+	am.Role = strPtrToType(data["role"])
+	am.User = strPtrToType(data["user"])
+	am.Sort = int64PtrToType(data["sort"])
+}
@@ -0,0 +1,203 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &CommentResource{}
+
+// CommentResource implements the directus_comment resource (Directus 11+)
+type CommentResource struct{ client *client.Directus }
+
+// CommentModel represents the comment resource model
+type CommentModel struct {
+	ID          types.String `tfsdk:"id"`
+	Collection  types.String `tfsdk:"collection"`
+	Item        types.String `tfsdk:"item"`
+	Comment     types.String `tfsdk:"comment"`
+	UserCreated types.String `tfsdk:"user_created"`
+	DateCreated types.String `tfsdk:"date_created"`
+}
+
+// NewCommentResource returns a new comment resource
+func NewCommentResource() resource.Resource { return &CommentResource{} }
+
+// Metadata returns the resource type name
+func (r *CommentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_comment"
+}
+
+// Schema defines the schema for the resource
+func (r *CommentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"item": rschema.StringAttribute{
+				Required:      true,
+				Description:   "primary key of the commented item",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"comment": rschema.StringAttribute{Required: true, Description: "markdown comment text"},
+			"user_created": rschema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"date_created": rschema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *CommentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new comment
+func (r *CommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CommentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{
+		"collection": plan.Collection.ValueString(),
+		"item":       plan.Item.ValueString(),
+		"comment":    plan.Comment.ValueString(),
+	}
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/comments", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the comment
+func (r *CommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CommentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/comments/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the comment text
+func (r *CommentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CommentModel
+	var state CommentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	payload := map[string]any{"comment": plan.Comment.ValueString()}
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/comments/"+plan.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the comment
+func (r *CommentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CommentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/comments/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_comment
+func (r *CommentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// helper: map API data into the model
+func (r *CommentResource) readIntoState(cm *CommentModel, data map[string]any) {
+	cm.ID = types.StringValue(str(data["id"]))
+	cm.Collection = types.StringValue(str(data["collection"]))
+	cm.Item = types.StringValue(str(data["item"]))
+	cm.Comment = types.StringValue(str(data["comment"]))
+	cm.UserCreated = strPtrToType(data["user_created"])
+	cm.DateCreated = strPtrToType(data["date_created"])
+}
@@ -0,0 +1,315 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &ContentVersionResource{}
+
+// ContentVersionResource implements the directus_content_version resource
+type ContentVersionResource struct{ client *client.Directus }
+
+// ContentVersionModel represents the content version resource model
+type ContentVersionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Collection  types.String `tfsdk:"collection"`
+	Item        types.String `tfsdk:"item"`
+	Promote     types.Bool   `tfsdk:"promote"`
+	Hash        types.String `tfsdk:"hash"`
+	DateCreated types.String `tfsdk:"date_created"`
+}
+
+// NewContentVersionResource returns a new content version resource
+func NewContentVersionResource() resource.Resource { return &ContentVersionResource{} }
+
+// Metadata returns the resource type name
+func (r *ContentVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_version"
+}
+
+// Schema defines the schema for the resource
+func (r *ContentVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":   rschema.StringAttribute{Computed: true},
+			"key":  rschema.StringAttribute{Required: true, Description: "unique key of the version for the item"},
+			"name": rschema.StringAttribute{Optional: true},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"item": rschema.StringAttribute{
+				Required:      true,
+				Description:   "primary key of the versioned item",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"promote": rschema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Promote the version's changes to the main item on apply. A version whose changes " +
+					"were promoted outside Terraform reads back as promoted; setting it back to false replaces " +
+					"the version with a new one based on the current main item.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+						var prior types.Bool
+						resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("promote"), &prior)...)
+						resp.RequiresReplace = prior.ValueBool() && !req.PlanValue.ValueBool()
+					}, "Unpromoting replaces the version.", "Unpromoting replaces the version."),
+				},
+			},
+			"hash":         rschema.StringAttribute{Computed: true, Description: "hash of the main item the version is based on"},
+			"date_created": rschema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *ContentVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new content version and optionally promotes it
+func (r *ContentVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContentVersionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{
+		"key":        plan.Key.ValueString(),
+		"name":       nullableStr(plan.Name),
+		"collection": plan.Collection.ValueString(),
+		"item":       plan.Item.ValueString(),
+	}
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/versions", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(str(apiResp.Data["id"]))
+
+	if plan.Promote.ValueBool() {
+		if err := r.promote(ctx, plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the content version and detects out-of-band promotion
+func (r *ContentVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContentVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refreshState(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the content version, promoting it when promote is set.
+// Unpromoting replaces the version instead.
+func (r *ContentVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContentVersionModel
+	var state ContentVersionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	id := plan.ID.ValueString()
+
+	payload := map[string]any{
+		"key":  plan.Key.ValueString(),
+		"name": nullableStr(plan.Name),
+	}
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/versions/"+id, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	if plan.Promote.ValueBool() && !state.Promote.ValueBool() {
+		if err := r.promote(ctx, id); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the content version
+func (r *ContentVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContentVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/versions/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_content_version
+func (r *ContentVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("promote"), false)...)
+}
+
+// Helper functions
+
+func (r *ContentVersionResource) post(ctx context.Context, url string, body any, out any) error {
+	httpResp, err := r.client.Request(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	return parseResp(httpResp, out)
+}
+
+// versionComparison is the result of /versions/{id}/compare
+type versionComparison struct {
+	MainHash string         `json:"mainHash"`
+	Current  map[string]any `json:"current"` // the version's changes
+	Main     map[string]any `json:"main"`    // the main item
+}
+
+func (r *ContentVersionResource) compare(ctx context.Context, id string) (versionComparison, error) {
+	apiResp := struct {
+		Data versionComparison `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/versions/"+id+"/compare", nil)
+	if err != nil {
+		return versionComparison{}, err
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		return versionComparison{}, err
+	}
+	return apiResp.Data, nil
+}
+
+// applied reports whether the version has changes and the main item already
+// holds all of them, which is what promoting the version leaves behind.
+func (c versionComparison) applied() bool {
+	if len(c.Current) == 0 {
+		return false
+	}
+	for field, v := range c.Current {
+		if !reflect.DeepEqual(c.Main[field], v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ContentVersionResource) promote(ctx context.Context, id string) error {
+	cmp, err := r.compare(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.post(ctx, "/versions/"+id+"/promote", map[string]any{"mainHash": cmp.MainHash}, nil)
+}
+
+// refreshState reads the version into vm. It returns false if the version no
+// longer exists or the request failed.
+func (r *ContentVersionResource) refreshState(ctx context.Context, vm *ContentVersionModel, diags diagCollector) bool {
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/versions/"+vm.ID.ValueString(), nil)
+	if err != nil {
+		diags.AddError("api error", err.Error())
+		return false
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			return false
+		}
+		diags.AddError("api error", err.Error())
+		return false
+	}
+
+	data := apiResp.Data
+	vm.ID = types.StringValue(str(data["id"]))
+	vm.Key = types.StringValue(str(data["key"]))
+	vm.Name = strPtrToType(data["name"])
+	vm.Collection = types.StringValue(str(data["collection"]))
+	vm.Item = types.StringValue(str(data["item"]))
+	vm.Hash = strPtrToType(data["hash"])
+	vm.DateCreated = strPtrToType(data["date_created"])
+
+	// A promoted version stays promoted, even once the main item moves on.
+	// Otherwise the version counts as promoted outside Terraform when the
+	// main item already holds all of its changes.
+	if !vm.Promote.ValueBool() {
+		cmp, err := r.compare(ctx, vm.ID.ValueString())
+		if err != nil {
+			diags.AddError("api error", err.Error())
+			return false
+		}
+		vm.Promote = types.BoolValue(cmp.applied())
+	}
+
+	return true
+}
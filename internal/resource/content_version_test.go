@@ -0,0 +1,73 @@
+package resource
+
+import "testing"
+
+func TestVersionComparisonApplied(t *testing.T) {
+	tests := []struct {
+		name string
+		cmp  versionComparison
+		want bool
+	}{
+		{
+			name: "no changes",
+			cmp:  versionComparison{Main: map[string]any{"title": "a"}},
+			want: false,
+		},
+		{
+			name: "changes pending",
+			cmp: versionComparison{
+				Current: map[string]any{"title": "b"},
+				Main:    map[string]any{"title": "a"},
+			},
+			want: false,
+		},
+		{
+			name: "changes applied",
+			cmp: versionComparison{
+				Current: map[string]any{"title": "b"},
+				Main:    map[string]any{"title": "b", "status": "draft"},
+			},
+			want: true,
+		},
+		{
+			name: "some changes applied",
+			cmp: versionComparison{
+				Current: map[string]any{"title": "b", "status": "published"},
+				Main:    map[string]any{"title": "b", "status": "draft"},
+			},
+			want: false,
+		},
+		{
+			name: "nested values applied",
+			cmp: versionComparison{
+				Current: map[string]any{"tags": []any{"x", "y"}, "seo": map[string]any{"slug": "b"}},
+				Main:    map[string]any{"tags": []any{"x", "y"}, "seo": map[string]any{"slug": "b"}},
+			},
+			want: true,
+		},
+		{
+			name: "field cleared",
+			cmp: versionComparison{
+				Current: map[string]any{"summary": nil},
+				Main:    map[string]any{"summary": nil},
+			},
+			want: true,
+		},
+		{
+			name: "field missing from main",
+			cmp: versionComparison{
+				Current: map[string]any{"summary": "s"},
+				Main:    map[string]any{},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cmp.applied(); got != tt.want {
+				t.Errorf("applied() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &DashboardResource{}
+
+// DashboardResource implements the directus_dashboard resource
+type DashboardResource struct{ client *client.Directus }
+
+// DashboardModel represents the dashboard resource model
+type DashboardModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Icon  types.String `tfsdk:"icon"`
+	Note  types.String `tfsdk:"note"`
+	Color types.String `tfsdk:"color"`
+}
+
+// NewDashboardResource returns a new dashboard resource
+func NewDashboardResource() resource.Resource { return &DashboardResource{} }
+
+// Metadata returns the resource type name
+func (r *DashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+// Schema defines the schema for the resource
+func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":    rschema.StringAttribute{Computed: true},
+			"name":  rschema.StringAttribute{Required: true},
+			"icon":  rschema.StringAttribute{Optional: true, Computed: true},
+			"note":  rschema.StringAttribute{Optional: true},
+			"color": rschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *DashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new dashboard
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/dashboards", r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the dashboard
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/dashboards/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the dashboard
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardModel
+	var state DashboardModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/dashboards/"+plan.ID.ValueString(), r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the dashboard; Directus removes its panels along with it
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/dashboards/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_dashboard
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Helper functions
+
+func (r *DashboardResource) payload(plan DashboardModel) map[string]any {
+	payload := map[string]any{
+		"name":  plan.Name.ValueString(),
+		"note":  nullableStr(plan.Note),
+		"color": nullableStr(plan.Color),
+	}
+	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
+		payload["icon"] = plan.Icon.ValueString()
+	}
+	return payload
+}
+
+// helper: map API data into the model
+func (r *DashboardResource) readIntoState(dm *DashboardModel, data map[string]any) {
+	dm.ID = types.StringValue(str(data["id"]))
+	dm.Name = types.StringValue(str(data["name"]))
+	dm.Icon = strPtrToType(data["icon"])
+	dm.Note = strPtrToType(data["note"])
+	dm.Color = strPtrToType(data["color"])
+}
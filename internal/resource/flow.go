@@ -0,0 +1,656 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &FlowResource{}
+	_ resource.ResourceWithValidateConfig = &FlowResource{}
+)
+
+// FlowResource implements the directus_flow resource
+type FlowResource struct{ client *client.Directus }
+
+// FlowModel represents the flow resource model
+type FlowModel struct {
+	ID             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	Icon           types.String         `tfsdk:"icon"`
+	Color          types.String         `tfsdk:"color"`
+	Description    types.String         `tfsdk:"description"`
+	Status         types.String         `tfsdk:"status"`
+	Trigger        types.String         `tfsdk:"trigger"`
+	Accountability types.String         `tfsdk:"accountability"`
+	Options        types.String         `tfsdk:"options"`   // JSON string
+	Operation      types.String         `tfsdk:"operation"` // id of the first operation
+	Operations     []FlowOperationModel `tfsdk:"operations"`
+}
+
+// FlowOperationModel represents an operation embedded in a flow
+type FlowOperationModel struct {
+	ID        types.String `tfsdk:"id"`
+	Key       types.String `tfsdk:"key"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	PositionX types.Int64  `tfsdk:"position_x"`
+	PositionY types.Int64  `tfsdk:"position_y"`
+	Options   types.String `tfsdk:"options"` // JSON string
+	Resolve   types.String `tfsdk:"resolve"` // key of the next operation on success
+	Reject    types.String `tfsdk:"reject"`  // key of the next operation on failure
+}
+
+// NewFlowResource returns a new flow resource
+func NewFlowResource() resource.Resource { return &FlowResource{} }
+
+// Metadata returns the resource type name
+func (r *FlowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+// Schema defines the schema for the resource
+func (r *FlowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":             rschema.StringAttribute{Computed: true},
+			"name":           rschema.StringAttribute{Required: true},
+			"icon":           rschema.StringAttribute{Optional: true},
+			"color":          rschema.StringAttribute{Optional: true},
+			"description":    rschema.StringAttribute{Optional: true},
+			"status":         rschema.StringAttribute{Optional: true, Computed: true, Description: "one of active, inactive"},
+			"trigger":        rschema.StringAttribute{Required: true, Description: "one of event, schedule, operation, webhook, manual"},
+			"accountability": rschema.StringAttribute{Optional: true, Computed: true, Description: "one of all, activity"},
+			"options":        rschema.StringAttribute{Optional: true, Description: "JSON string of trigger options"},
+			"operation":      rschema.StringAttribute{Computed: true, Description: "ID of the operation run first by the trigger"},
+			"operations": rschema.ListNestedAttribute{
+				Optional: true,
+				Description: "Operations graph of the flow. The first operation that is not the resolve/reject " +
+					"target of another one is attached to the trigger. When omitted, operations are left untouched.",
+				NestedObject: rschema.NestedAttributeObject{
+					Attributes: map[string]rschema.Attribute{
+						"id":         rschema.StringAttribute{Computed: true},
+						"key":        rschema.StringAttribute{Required: true},
+						"name":       rschema.StringAttribute{Optional: true},
+						"type":       rschema.StringAttribute{Required: true, Description: "operation type, e.g. log, request, item-create"},
+						"position_x": rschema.Int64Attribute{Optional: true, Computed: true},
+						"position_y": rschema.Int64Attribute{Optional: true, Computed: true},
+						"options":    rschema.StringAttribute{Optional: true, Description: "JSON string of operation options"},
+						"resolve":    rschema.StringAttribute{Optional: true, Description: "key of the operation run on success"},
+						"reject":     rschema.StringAttribute{Optional: true, Description: "key of the operation run on failure"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *FlowResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig checks that the operations form a valid graph
+func (r *FlowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg FlowModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateFlowOperations(cfg.Operations); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("operations"), "invalid operations", err.Error())
+	}
+}
+
+// Create creates a new flow together with its operations
+func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FlowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := flowOperationOrder(plan.Operations)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid operations", err.Error())
+		return
+	}
+
+	payload := r.payload(plan)
+	for k, v := range payload {
+		if v == nil {
+			delete(payload, k)
+		}
+	}
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/flows", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(str(apiResp.Data["id"]))
+
+	// Successors are created before the operations pointing at them so the
+	// resolve/reject ids are known when each operation is posted.
+	ids := map[string]string{}
+	for _, i := range order {
+		op := plan.Operations[i]
+		id, err := r.createOperation(ctx, plan.ID.ValueString(), i, op, ids)
+		if err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		ids[op.Key.ValueString()] = id
+	}
+
+	if root := flowRootOperation(plan.Operations); root != "" {
+		if err := setFlowOperation(ctx, r.client, plan.ID.ValueString(), ids[root]); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the flow
+func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FlowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refreshState(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the flow and reconciles its operations graph
+func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FlowModel
+	var state FlowModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	flowID := plan.ID.ValueString()
+
+	order, err := flowOperationOrder(plan.Operations)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid operations", err.Error())
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/flows/"+flowID, r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	if plan.Operations != nil {
+		if err := r.reconcileOperations(ctx, flowID, state.Operations, plan.Operations, order); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the flow; Directus removes its operations along with it
+func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FlowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/flows/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_flow
+func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Helper functions
+
+func (r *FlowResource) payload(plan FlowModel) map[string]any {
+	payload := map[string]any{
+		"name":        plan.Name.ValueString(),
+		"trigger":     plan.Trigger.ValueString(),
+		"icon":        nullableStr(plan.Icon),
+		"color":       nullableStr(plan.Color),
+		"description": nullableStr(plan.Description),
+		"options":     nil,
+	}
+	if s := plan.Options.ValueString(); s != "" {
+		payload["options"] = jsonRaw(s)
+	}
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+		payload["status"] = plan.Status.ValueString()
+	}
+	if !plan.Accountability.IsNull() && !plan.Accountability.IsUnknown() {
+		payload["accountability"] = plan.Accountability.ValueString()
+	}
+	return payload
+}
+
+// operationPayload builds the API body for an operation, resolving the
+// resolve/reject keys through ids.
+func operationPayload(flowID string, index int, op FlowOperationModel, ids map[string]string) map[string]any {
+	payload := map[string]any{
+		"flow":    flowID,
+		"key":     op.Key.ValueString(),
+		"type":    op.Type.ValueString(),
+		"name":    nullableStr(op.Name),
+		"options": nil,
+		"resolve": nil,
+		"reject":  nil,
+	}
+	if op.Name.IsNull() || op.Name.ValueString() == "" {
+		payload["name"] = op.Key.ValueString()
+	}
+	if s := op.Options.ValueString(); s != "" {
+		payload["options"] = jsonRaw(s)
+	}
+	// Lay out operations left to right on the flow grid unless positioned explicitly
+	if !op.PositionX.IsNull() && !op.PositionX.IsUnknown() {
+		payload["position_x"] = op.PositionX.ValueInt64()
+	} else {
+		payload["position_x"] = 19 + 18*index
+	}
+	if !op.PositionY.IsNull() && !op.PositionY.IsUnknown() {
+		payload["position_y"] = op.PositionY.ValueInt64()
+	} else {
+		payload["position_y"] = 1
+	}
+	if k := op.Resolve.ValueString(); k != "" {
+		payload["resolve"] = ids[k]
+	}
+	if k := op.Reject.ValueString(); k != "" {
+		payload["reject"] = ids[k]
+	}
+	return payload
+}
+
+func (r *FlowResource) createOperation(ctx context.Context, flowID string, index int, op FlowOperationModel, ids map[string]string) (string, error) {
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/operations", operationPayload(flowID, index, op, ids))
+	if err != nil {
+		return "", err
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		return "", fmt.Errorf("operation %q: %w", op.Key.ValueString(), err)
+	}
+	return str(apiResp.Data["id"]), nil
+}
+
+func (r *FlowResource) patchOperation(ctx context.Context, id string, payload map[string]any) error {
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/operations/"+id, payload)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	return parseResp(httpResp, nil)
+}
+
+// setFlowOperation attaches the operation to the flow's trigger, or detaches
+// it when operationID is empty.
+func setFlowOperation(ctx context.Context, c *client.Directus, flowID, operationID string) error {
+	var op any
+	if operationID != "" {
+		op = operationID
+	}
+	httpResp, err := c.Request(ctx, http.MethodPatch, "/flows/"+flowID, map[string]any{"operation": op})
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	return parseResp(httpResp, nil)
+}
+
+// reconcileOperations diffs the planned operations against the current ones
+// by key. Links that change are cleared first because Directus enforces unique
+// resolve/reject targets, removed operations are deleted, and the remaining
+// operations are created or patched with successors first.
+func (r *FlowResource) reconcileOperations(ctx context.Context, flowID string, current, desired []FlowOperationModel, order []int) error {
+	currentByKey := map[string]FlowOperationModel{}
+	for _, op := range current {
+		currentByKey[op.Key.ValueString()] = op
+	}
+	desiredByKey := map[string]FlowOperationModel{}
+	for _, op := range desired {
+		desiredByKey[op.Key.ValueString()] = op
+	}
+
+	// 1. Unlink operations whose successors change or that are going away
+	for key, op := range currentByKey {
+		want, keep := desiredByKey[key]
+		if op.Resolve.IsNull() && op.Reject.IsNull() {
+			continue
+		}
+		if keep && op.Resolve.Equal(want.Resolve) && op.Reject.Equal(want.Reject) {
+			continue
+		}
+		if err := r.patchOperation(ctx, op.ID.ValueString(), map[string]any{"resolve": nil, "reject": nil}); err != nil && !isNotFound(err) {
+			return fmt.Errorf("operation %q: %w", key, err)
+		}
+	}
+
+	// 2. Delete operations no longer declared, detaching the trigger first in
+	// case it points at one of them
+	var removed []string
+	for key := range currentByKey {
+		if _, keep := desiredByKey[key]; !keep {
+			removed = append(removed, key)
+		}
+	}
+	if len(removed) > 0 {
+		if err := setFlowOperation(ctx, r.client, flowID, ""); err != nil {
+			return err
+		}
+	}
+	for _, key := range removed {
+		op := currentByKey[key]
+		httpResp, err := r.client.Request(ctx, http.MethodDelete, "/operations/"+op.ID.ValueString(), nil)
+		if err != nil {
+			return err
+		}
+		err = parseResp(httpResp, nil)
+		httpResp.Body.Close()
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("operation %q: %w", key, err)
+		}
+	}
+
+	// 3. Create or patch the declared operations, successors first
+	ids := map[string]string{}
+	for _, i := range order {
+		op := desired[i]
+		key := op.Key.ValueString()
+		if cur, ok := currentByKey[key]; ok && cur.ID.ValueString() != "" {
+			ids[key] = cur.ID.ValueString()
+			payload := operationPayload(flowID, i, op, ids)
+			// Keep positions chosen in the app when they aren't configured
+			if op.PositionX.IsNull() || op.PositionX.IsUnknown() {
+				delete(payload, "position_x")
+			}
+			if op.PositionY.IsNull() || op.PositionY.IsUnknown() {
+				delete(payload, "position_y")
+			}
+			if err := r.patchOperation(ctx, ids[key], payload); err != nil {
+				return fmt.Errorf("operation %q: %w", key, err)
+			}
+			continue
+		}
+		id, err := r.createOperation(ctx, flowID, i, op, ids)
+		if err != nil {
+			return err
+		}
+		ids[key] = id
+	}
+
+	// 4. Attach the root operation to the trigger
+	return setFlowOperation(ctx, r.client, flowID, ids[flowRootOperation(desired)])
+}
+
+// refreshState reads the flow and its operations into fm. It returns false if
+// the flow no longer exists or the request failed.
+func (r *FlowResource) refreshState(ctx context.Context, fm *FlowModel, diags diagCollector) bool {
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/flows/"+fm.ID.ValueString()+"?fields=*,operations.*", nil)
+	if err != nil {
+		diags.AddError("api error", err.Error())
+		return false
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			return false
+		}
+		diags.AddError("api error", err.Error())
+		return false
+	}
+
+	data := apiResp.Data
+	fm.ID = types.StringValue(str(data["id"]))
+	fm.Name = types.StringValue(str(data["name"]))
+	fm.Icon = strPtrToType(data["icon"])
+	fm.Color = strPtrToType(data["color"])
+	fm.Description = strPtrToType(data["description"])
+	fm.Status = strPtrToType(data["status"])
+	fm.Trigger = types.StringValue(str(data["trigger"]))
+	fm.Accountability = strPtrToType(data["accountability"])
+	fm.Options = jsonStateValue(fm.Options, data["options"])
+	fm.Operation = strPtrToType(data["operation"])
+
+	// Only track operations when they are managed inline
+	if fm.Operations == nil {
+		return true
+	}
+
+	apiOps := map[string]map[string]any{}
+	keys := map[string]string{}
+	var apiOrder []string
+	if v, ok := data["operations"].([]any); ok {
+		for _, o := range v {
+			if m, ok := o.(map[string]any); ok {
+				id := str(m["id"])
+				apiOps[id] = m
+				keys[id] = str(m["key"])
+				apiOrder = append(apiOrder, id)
+			}
+		}
+	}
+
+	// Keep the configured order, matching by key, and append unknown operations
+	prior := map[string]FlowOperationModel{}
+	for _, op := range fm.Operations {
+		prior[op.Key.ValueString()] = op
+	}
+	byKey := map[string]string{}
+	for id, key := range keys {
+		byKey[key] = id
+	}
+	ops := make([]FlowOperationModel, 0, len(apiOps))
+	seen := map[string]struct{}{}
+	for _, op := range fm.Operations {
+		id, ok := byKey[op.Key.ValueString()]
+		if !ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ops = append(ops, flattenFlowOperation(apiOps[id], op, keys))
+	}
+	for _, id := range apiOrder {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		ops = append(ops, flattenFlowOperation(apiOps[id], prior[keys[id]], keys))
+	}
+	fm.Operations = ops
+
+	return true
+}
+
+func flattenFlowOperation(data map[string]any, prior FlowOperationModel, keys map[string]string) FlowOperationModel {
+	op := FlowOperationModel{
+		ID:        types.StringValue(str(data["id"])),
+		Key:       types.StringValue(str(data["key"])),
+		Name:      strPtrToType(data["name"]),
+		Type:      types.StringValue(str(data["type"])),
+		PositionX: int64PtrToType(data["position_x"]),
+		PositionY: int64PtrToType(data["position_y"]),
+		Options:   jsonStateValue(prior.Options, data["options"]),
+		Resolve:   types.StringNull(),
+		Reject:    types.StringNull(),
+	}
+	// The name defaults to the key when not configured
+	if prior.Name.IsNull() && op.Name.ValueString() == op.Key.ValueString() {
+		op.Name = types.StringNull()
+	}
+	if id := str(data["resolve"]); id != "" {
+		op.Resolve = types.StringValue(keys[id])
+	}
+	if id := str(data["reject"]); id != "" {
+		op.Reject = types.StringValue(keys[id])
+	}
+	return op
+}
+
+// validateFlowOperations checks key uniqueness, successor references and
+// that every operation has at most one predecessor.
+func validateFlowOperations(ops []FlowOperationModel) error {
+	keys := map[string]struct{}{}
+	for _, op := range ops {
+		if op.Key.IsUnknown() {
+			return nil
+		}
+		k := op.Key.ValueString()
+		if _, dup := keys[k]; dup {
+			return fmt.Errorf("duplicate operation key %q", k)
+		}
+		keys[k] = struct{}{}
+	}
+
+	predecessor := map[string]string{}
+	for _, op := range ops {
+		for _, next := range []types.String{op.Resolve, op.Reject} {
+			if next.IsNull() || next.IsUnknown() {
+				continue
+			}
+			n := next.ValueString()
+			if _, ok := keys[n]; !ok {
+				return fmt.Errorf("operation %q references unknown operation %q", op.Key.ValueString(), n)
+			}
+			if n == op.Key.ValueString() {
+				return fmt.Errorf("operation %q references itself", n)
+			}
+			if p, ok := predecessor[n]; ok {
+				return fmt.Errorf("operation %q is already the successor of %q", n, p)
+			}
+			predecessor[n] = op.Key.ValueString()
+		}
+	}
+
+	_, err := flowOperationOrder(ops)
+	return err
+}
+
+// flowOperationOrder returns the operation indexes ordered so that every
+// operation comes after its resolve/reject successors.
+func flowOperationOrder(ops []FlowOperationModel) ([]int, error) {
+	index := map[string]int{}
+	for i, op := range ops {
+		index[op.Key.ValueString()] = i
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	marks := make([]int, len(ops))
+	order := make([]int, 0, len(ops))
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch marks[i] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("operation %q is part of a cycle", ops[i].Key.ValueString())
+		}
+		marks[i] = visiting
+		for _, next := range []types.String{ops[i].Resolve, ops[i].Reject} {
+			if j, ok := index[next.ValueString()]; ok && !next.IsNull() {
+				if err := visit(j); err != nil {
+					return err
+				}
+			}
+		}
+		marks[i] = done
+		order = append(order, i)
+		return nil
+	}
+
+	for i := range ops {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// flowRootOperation returns the key of the first operation that isn't the
+// successor of another one.
+func flowRootOperation(ops []FlowOperationModel) string {
+	targets := map[string]struct{}{}
+	for _, op := range ops {
+		if !op.Resolve.IsNull() {
+			targets[op.Resolve.ValueString()] = struct{}{}
+		}
+		if !op.Reject.IsNull() {
+			targets[op.Reject.ValueString()] = struct{}{}
+		}
+	}
+	for _, op := range ops {
+		if _, ok := targets[op.Key.ValueString()]; !ok {
+			return op.Key.ValueString()
+		}
+	}
+	return ""
+}
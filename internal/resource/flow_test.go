@@ -0,0 +1,137 @@
+package resource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func flowOp(key, resolve, reject string) FlowOperationModel {
+	op := FlowOperationModel{
+		Key:     types.StringValue(key),
+		Resolve: types.StringNull(),
+		Reject:  types.StringNull(),
+	}
+	if resolve != "" {
+		op.Resolve = types.StringValue(resolve)
+	}
+	if reject != "" {
+		op.Reject = types.StringValue(reject)
+	}
+	return op
+}
+
+func TestFlowOperationOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     []FlowOperationModel
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "empty",
+			ops:  nil,
+			want: []int{},
+		},
+		{
+			name: "chain",
+			ops:  []FlowOperationModel{flowOp("a", "b", ""), flowOp("b", "c", ""), flowOp("c", "", "")},
+			want: []int{2, 1, 0},
+		},
+		{
+			name: "branches",
+			ops:  []FlowOperationModel{flowOp("a", "b", "c"), flowOp("b", "", ""), flowOp("c", "", "")},
+			want: []int{1, 2, 0},
+		},
+		{
+			name: "successors listed first",
+			ops:  []FlowOperationModel{flowOp("c", "", ""), flowOp("b", "c", ""), flowOp("a", "b", "")},
+			want: []int{0, 1, 2},
+		},
+		{
+			name:    "cycle",
+			ops:     []FlowOperationModel{flowOp("a", "b", ""), flowOp("b", "a", "")},
+			wantErr: "cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := flowOperationOrder(tt.ops)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFlowOperations(t *testing.T) {
+	unknownKey := flowOp("", "", "")
+	unknownKey.Key = types.StringUnknown()
+
+	tests := []struct {
+		name    string
+		ops     []FlowOperationModel
+		wantErr string
+	}{
+		{
+			name: "valid",
+			ops:  []FlowOperationModel{flowOp("a", "b", "c"), flowOp("b", "", ""), flowOp("c", "", "")},
+		},
+		{
+			name: "unknown key skips validation",
+			ops:  []FlowOperationModel{unknownKey, flowOp("a", "missing", "")},
+		},
+		{
+			name:    "duplicate key",
+			ops:     []FlowOperationModel{flowOp("a", "", ""), flowOp("a", "", "")},
+			wantErr: `duplicate operation key "a"`,
+		},
+		{
+			name:    "unknown successor",
+			ops:     []FlowOperationModel{flowOp("a", "missing", "")},
+			wantErr: `references unknown operation "missing"`,
+		},
+		{
+			name:    "self reference",
+			ops:     []FlowOperationModel{flowOp("a", "", "a")},
+			wantErr: `operation "a" references itself`,
+		},
+		{
+			name:    "shared successor",
+			ops:     []FlowOperationModel{flowOp("a", "c", ""), flowOp("b", "c", ""), flowOp("c", "", "")},
+			wantErr: `already the successor of "a"`,
+		},
+		{
+			name:    "cycle",
+			ops:     []FlowOperationModel{flowOp("a", "b", ""), flowOp("b", "a", "")},
+			wantErr: "cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFlowOperations(tt.ops)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
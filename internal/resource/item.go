@@ -0,0 +1,326 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.ResourceWithImportState = &ItemResource{}
+	_ resource.ResourceWithModifyPlan  = &ItemResource{}
+)
+
+// ItemResource implements the directus_item resource for records of user
+// collections
+type ItemResource struct{ client *client.Directus }
+
+// ItemModel represents the item resource model
+type ItemModel struct {
+	ID              types.String `tfsdk:"id"`
+	Collection      types.String `tfsdk:"collection"`
+	PrimaryKeyField types.String `tfsdk:"primary_key_field"`
+	Data            jsonValue    `tfsdk:"data"`
+}
+
+// NewItemResource returns a new item resource
+func NewItemResource() resource.Resource { return &ItemResource{} }
+
+// Metadata returns the resource type name
+func (r *ItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+// Schema defines the schema for the resource
+func (r *ItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:      true,
+				Description:   "primary key of the item",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"primary_key_field": rschema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("id"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"data": rschema.StringAttribute{
+				Required:   true,
+				CustomType: jsonType{},
+				Description: "JSON object of the item's fields. Only the fields present here are managed; " +
+					"removing a field stops managing it without clearing it.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *ItemResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new item
+func (r *ItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ItemModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/items/"+plan.Collection.ValueString(), jsonRaw(plan.Data.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseRespNumbers(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	pk := str(apiResp.Data[plan.PrimaryKeyField.ValueString()])
+	if pk == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("primary_key_field"), "missing primary key",
+			fmt.Sprintf("The created item has no %q field; set primary_key_field to the primary key of %s.",
+				plan.PrimaryKeyField.ValueString(), plan.Collection.ValueString()))
+		return
+	}
+	plan.ID = types.StringValue(pk)
+	plan.Data = managedFields(plan.Data, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// ModifyPlan marks id unknown when data changes the primary key, which would
+// otherwise keep its prior value through UseStateForUnknown
+func (r *ItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ItemModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Data.IsUnknown() || plan.PrimaryKeyField.IsUnknown() {
+		plan.ID = types.StringUnknown()
+	} else {
+		var data map[string]any
+		if err := decodeNumbers(strings.NewReader(plan.Data.ValueString()), &data); err != nil {
+			return
+		}
+		v, ok := data[plan.PrimaryKeyField.ValueString()]
+		if !ok || str(v) == state.ID.ValueString() {
+			return
+		}
+		plan.ID = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+}
+
+// Read reads the managed fields of the item
+func (r *ItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, itemPath(state.Collection.ValueString(), state.ID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseRespNumbers(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		// Directus answers 403 both for items that don't exist and for
+		// items the token can't read. Only an admin token rules out the latter.
+		if isForbidden(err) {
+			if admin, adminErr := r.canReadAll(ctx); adminErr == nil && admin {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	state.Data = managedFields(state.Data, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update patches the managed fields of the item
+func (r *ItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ItemModel
+	var state ItemModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, itemPath(plan.Collection.ValueString(), plan.ID.ValueString()), jsonRaw(plan.Data.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseRespNumbers(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	// The primary key itself may have been changed through data
+	if v, ok := apiResp.Data[plan.PrimaryKeyField.ValueString()]; ok {
+		plan.ID = types.StringValue(str(v))
+	}
+	plan.Data = managedFields(plan.Data, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the item
+func (r *ItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, itemPath(state.Collection.ValueString(), state.ID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState imports an item from "collection/id" or "collection/id/primary_key_field"
+func (r *ItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected \"collection/id\" or \"collection/id/primary_key_field\", got: %q", req.ID),
+		)
+		return
+	}
+	pkField := "id"
+	if len(parts) == 3 {
+		pkField = parts[2]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_key_field"), pkField)...)
+}
+
+// Helper functions
+
+func itemPath(collection, id string) string {
+	return "/items/" + collection + "/" + url.PathEscape(id)
+}
+
+func isForbidden(err error) bool {
+	return strings.Contains(err.Error(), "directus api 403")
+}
+
+// canReadAll reports whether the token has admin access, in which case a 403
+// can only mean the item doesn't exist.
+func (r *ItemResource) canReadAll(ctx context.Context) (bool, error) {
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/policies/me/globals", nil)
+	if err != nil {
+		return false, err
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		return false, err
+	}
+	return boolVal(apiResp.Data["admin_access"]), nil
+}
+
+// managedFields narrows the item returned by the API to the fields present in
+// prior, keeping prior when nothing changed. Without a prior object, e.g.
+// after an import, every field is returned.
+func managedFields(prior jsonValue, data map[string]any) jsonValue {
+	fields := data
+	var p map[string]any
+	if !prior.IsNull() && !prior.IsUnknown() && json.Unmarshal([]byte(prior.ValueString()), &p) == nil {
+		fields = make(map[string]any, len(p))
+		for k := range p {
+			fields[k] = data[k]
+		}
+	}
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return jsonNull()
+	}
+	next := jsonValue{StringValue: types.StringValue(string(b))}
+	if equal, _ := prior.StringSemanticEquals(context.Background(), next); equal {
+		return prior
+	}
+	return next
+}
+
+// parseRespNumbers is parseResp keeping numbers as json.Number, so that
+// integer primary keys beyond float64 precision survive the round trip
+func parseRespNumbers(resp *http.Response, out any) error {
+	return client.ParseResponseNumbers(resp, out)
+}
+
+func decodeNumbers(r io.Reader, out any) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(out)
+}
@@ -0,0 +1,62 @@
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestManagedFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior jsonValue
+		data  map[string]any
+		want  string
+	}{
+		{
+			name:  "every field without prior",
+			prior: jsonNull(),
+			data:  map[string]any{"b": json.Number("1"), "a": "x"},
+			want:  `{"a":"x","b":1}`,
+		},
+		{
+			name:  "unchanged prior is kept as written",
+			prior: jsonValue{StringValue: types.StringValue(`{ "a": "x" }`)},
+			data:  map[string]any{"a": "x", "b": json.Number("2")},
+			want:  `{ "a": "x" }`,
+		},
+		{
+			name:  "only managed fields",
+			prior: jsonValue{StringValue: types.StringValue(`{"a":"x"}`)},
+			data:  map[string]any{"a": "y", "b": json.Number("2")},
+			want:  `{"a":"y"}`,
+		},
+		{
+			name:  "missing field is null",
+			prior: jsonValue{StringValue: types.StringValue(`{"a":1,"c":2}`)},
+			data:  map[string]any{"a": json.Number("1")},
+			want:  `{"a":1,"c":null}`,
+		},
+		{
+			name:  "large integer unchanged",
+			prior: jsonValue{StringValue: types.StringValue(`{"id":9007199254740993}`)},
+			data:  map[string]any{"id": json.Number("9007199254740993")},
+			want:  `{"id":9007199254740993}`,
+		},
+		{
+			name:  "large integer changed",
+			prior: jsonValue{StringValue: types.StringValue(`{"id":9007199254740993}`)},
+			data:  map[string]any{"id": json.Number("9007199254740992")},
+			want:  `{"id":9007199254740992}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := managedFields(tt.prior, tt.data).ValueString(); got != tt.want {
+				t.Errorf("managedFields = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
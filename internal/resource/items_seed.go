@@ -0,0 +1,481 @@
+package resource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithModifyPlan = &ItemsSeedResource{}
+
+// ItemsSeedResource implements the directus_items_seed resource, which loads
+// the rows of a local CSV, JSON or NDJSON file into a collection
+type ItemsSeedResource struct{ client *client.Directus }
+
+// ItemsSeedModel represents the items seed resource model
+type ItemsSeedModel struct {
+	ID              types.String `tfsdk:"id"` // same as collection
+	Collection      types.String `tfsdk:"collection"`
+	Source          types.String `tfsdk:"source"`
+	Format          types.String `tfsdk:"format"`
+	KeyField        types.String `tfsdk:"key_field"`
+	PrimaryKeyField types.String `tfsdk:"primary_key_field"`
+	BatchSize       types.Int64  `tfsdk:"batch_size"`
+	ContentHash     types.String `tfsdk:"content_hash"`
+	Keys            types.Set    `tfsdk:"keys"`
+}
+
+// NewItemsSeedResource returns a new items seed resource
+func NewItemsSeedResource() resource.Resource { return &ItemsSeedResource{} }
+
+// Metadata returns the resource type name
+func (r *ItemsSeedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_items_seed"
+}
+
+// Schema defines the schema for the resource
+func (r *ItemsSeedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source": rschema.StringAttribute{Required: true, Description: "path to a local CSV, JSON (array of objects) or NDJSON file"},
+			"format": rschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "one of csv, json, ndjson; inferred from the source extension when omitted",
+			},
+			"key_field": rschema.StringAttribute{
+				Required:      true,
+				Description:   "field identifying a row, used to match rows with existing items",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"primary_key_field": rschema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("id"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"batch_size": rschema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(100),
+			},
+			"content_hash": rschema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the source file, left empty when some rows failed so the next apply retries them",
+			},
+			"keys": rschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "key_field values of the seeded rows; rows dropped from the source are deleted",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *ItemsSeedResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// ModifyPlan hashes the source file so that content changes trigger an update
+func (r *ItemsSeedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ItemsSeedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "unreadable source", err.Error())
+		return
+	}
+	sum := sha256.Sum256(content)
+	plan.ContentHash = types.StringValue(hex.EncodeToString(sum[:]))
+
+	if plan.Format.IsNull() || plan.Format.IsUnknown() {
+		plan.Format = types.StringValue(seedFormat(plan.Source.ValueString()))
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ItemsSeedModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ID = state.ID
+		plan.Keys = types.SetUnknown(types.StringType)
+		if state.ContentHash.Equal(plan.ContentHash) && state.KeyField.Equal(plan.KeyField) &&
+			state.Format.Equal(plan.Format) && state.PrimaryKeyField.Equal(plan.PrimaryKeyField) {
+			plan.Keys = state.Keys
+			if state.Source.Equal(plan.Source) && state.BatchSize.Equal(plan.BatchSize) {
+				resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+				return
+			}
+		}
+	}
+
+	// The source is seeded again; the hash is only recorded if every row succeeds
+	plan.ContentHash = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create seeds the collection
+func (r *ItemsSeedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ItemsSeedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Collection
+	r.seed(ctx, &plan, nil, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read detects seeded rows deleted outside Terraform
+func (r *ItemsSeedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ItemsSeedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.existing(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &keys, false)...)
+	for _, k := range keys {
+		// Clearing the hash makes the next plan re-seed the missing rows
+		if _, ok := existing[k]; !ok {
+			state.ContentHash = types.StringNull()
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update upserts the rows of the source and deletes the ones dropped from it
+func (r *ItemsSeedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ItemsSeedModel
+	var state ItemsSeedModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	r.seed(ctx, &plan, previous, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes every seeded row
+func (r *ItemsSeedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ItemsSeedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.existing(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	var pks []any
+	for _, k := range keys {
+		if pk, ok := existing[k]; ok {
+			pks = append(pks, pk)
+		}
+	}
+	// Keep the resource in state while rows remain, so destroy can be retried
+	if failed := r.batch(ctx, http.MethodDelete, state, pks, &resp.Diagnostics); len(failed) > 0 {
+		resp.Diagnostics.AddError("delete failed", fmt.Sprintf("%d seeded rows could not be deleted", len(failed)))
+	}
+}
+
+// Helper functions
+
+// seedFormat infers the file format from its extension
+func seedFormat(source string) string {
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".json":
+		return "json"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	default:
+		return "csv"
+	}
+}
+
+// readSeedRows parses the source file into rows. Empty CSV cells become null.
+func readSeedRows(content []byte, format string) ([]map[string]any, error) {
+	var rows []map[string]any
+	switch format {
+	case "json":
+		if err := decodeNumbers(bytes.NewReader(content), &rows); err != nil {
+			return nil, fmt.Errorf("parse json: %w", err)
+		}
+	case "ndjson":
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			var row map[string]any
+			if err := decodeNumbers(strings.NewReader(text), &row); err != nil {
+				return nil, fmt.Errorf("parse line %d: %w", line, err)
+			}
+			rows = append(rows, row)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	case "csv":
+		reader := csv.NewReader(bytes.NewReader(content))
+		header, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("parse csv header: %w", err)
+		}
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("parse csv: %w", err)
+			}
+			row := make(map[string]any, len(header))
+			for i, field := range header {
+				if i < len(record) && record[i] != "" {
+					row[field] = record[i]
+				} else {
+					row[field] = nil
+				}
+			}
+			rows = append(rows, row)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format %q, expected csv, json or ndjson", format)
+	}
+	return rows, nil
+}
+
+// existing maps the key_field value of every item in the collection to its
+// primary key
+func (r *ItemsSeedResource) existing(ctx context.Context, m ItemsSeedModel) (map[string]any, error) {
+	keyField, pkField := m.KeyField.ValueString(), m.PrimaryKeyField.ValueString()
+	items, err := r.client.GetAll(ctx, "/items/"+m.Collection.ValueString(), url.Values{
+		"fields": {pkField + "," + keyField},
+		"sort":   {pkField},
+	})
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]any, len(items))
+	for _, item := range items {
+		existing[str(item[keyField])] = item[pkField]
+	}
+	return existing, nil
+}
+
+// seed upserts the rows of the source and deletes rows seeded previously that
+// are no longer present. Rows that fail are reported individually as warnings
+// and left out of the recorded keys, so the rest of the seed still applies.
+func (r *ItemsSeedResource) seed(ctx context.Context, m *ItemsSeedModel, previous []string, diags *diag.Diagnostics) {
+	keyField, pkField := m.KeyField.ValueString(), m.PrimaryKeyField.ValueString()
+
+	// Keep the previous keys if seeding stops early so they can still be cleaned up
+	prior := make([]attr.Value, 0, len(previous))
+	for _, k := range previous {
+		prior = append(prior, types.StringValue(k))
+	}
+	m.Keys, _ = types.SetValue(types.StringType, prior)
+
+	// The source may only have been known at apply time
+	if m.Format.IsNull() || m.Format.IsUnknown() {
+		m.Format = types.StringValue(seedFormat(m.Source.ValueString()))
+	}
+	content, err := os.ReadFile(m.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "unreadable source", err.Error())
+		return
+	}
+	sum := sha256.Sum256(content)
+	m.ContentHash = types.StringNull()
+
+	rows, err := readSeedRows(content, m.Format.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "invalid source", err.Error())
+		return
+	}
+
+	existing, err := r.existing(ctx, *m)
+	if err != nil {
+		diags.AddError("api error", err.Error())
+		return
+	}
+
+	var creates, updates []any
+	rowKeys := map[string]int{}
+	for i, row := range rows {
+		line := i + 1
+		key := str(row[keyField])
+		if key == "" {
+			diags.AddWarning(fmt.Sprintf("row %d skipped", line), fmt.Sprintf("missing value for key field %q", keyField))
+			continue
+		}
+		if first, dup := rowKeys[key]; dup {
+			diags.AddWarning(fmt.Sprintf("row %d skipped", line), fmt.Sprintf("duplicate key %q, first seen in row %d", key, first))
+			continue
+		}
+		rowKeys[key] = line
+
+		if pk, ok := existing[key]; ok {
+			row[pkField] = pk
+			updates = append(updates, row)
+		} else {
+			creates = append(creates, row)
+		}
+	}
+
+	failed := r.batch(ctx, http.MethodPost, *m, creates, diags)
+	for k := range r.batch(ctx, http.MethodPatch, *m, updates, diags) {
+		failed[k] = struct{}{}
+	}
+
+	// Rows that were seeded before but dropped from the source
+	var deletes []any
+	deleteKeys := map[string]string{}
+	for _, k := range previous {
+		if _, keep := rowKeys[k]; keep {
+			continue
+		}
+		if pk, ok := existing[k]; ok {
+			deletes = append(deletes, pk)
+			deleteKeys[str(pk)] = k
+		}
+	}
+	failedDeletes := r.batch(ctx, http.MethodDelete, *m, deletes, diags)
+
+	keys := make([]attr.Value, 0, len(rowKeys))
+	for k := range rowKeys {
+		if _, bad := failed[k]; !bad {
+			keys = append(keys, types.StringValue(k))
+		}
+	}
+	// Rows that couldn't be deleted stay recorded so the next apply retries them
+	for pk := range failedDeletes {
+		keys = append(keys, types.StringValue(deleteKeys[pk]))
+	}
+	m.Keys, _ = types.SetValue(types.StringType, keys)
+
+	if sent := len(creates) + len(updates); sent > 0 && len(failed) == sent {
+		diags.AddError("seed failed", fmt.Sprintf("none of the %d rows of %s could be written", sent, m.Source.ValueString()))
+		return
+	}
+	// Without a hash the next plan seeds the source again and retries the failed rows
+	if len(failed) == 0 && len(failedDeletes) == 0 {
+		m.ContentHash = types.StringValue(hex.EncodeToString(sum[:]))
+	}
+}
+
+// batch sends items in chunks of batch_size. When a chunk is rejected its
+// entries are retried one by one so the failing rows can be reported as
+// warnings. It returns the key_field values of the rows that failed, or the
+// primary keys for deletes.
+func (r *ItemsSeedResource) batch(ctx context.Context, method string, m ItemsSeedModel, items []any, diags *diag.Diagnostics) map[string]struct{} {
+	failed := map[string]struct{}{}
+	size := int(m.BatchSize.ValueInt64())
+	if size <= 0 {
+		size = 100
+	}
+	collection := "/items/" + m.Collection.ValueString()
+
+	send := func(body any) error {
+		httpResp, err := r.client.Request(ctx, method, collection, body)
+		if err != nil {
+			return err
+		}
+		defer httpResp.Body.Close()
+		err = parseResp(httpResp, nil)
+		if method == http.MethodDelete && err != nil && isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	for start := 0; start < len(items); start += size {
+		chunk := items[start:min(start+size, len(items))]
+		if err := send(chunk); err == nil {
+			continue
+		}
+		for _, item := range chunk {
+			if err := send([]any{item}); err != nil {
+				label := str(item)
+				if row, ok := item.(map[string]any); ok {
+					label = str(row[m.KeyField.ValueString()])
+				}
+				failed[label] = struct{}{}
+				diags.AddWarning(fmt.Sprintf("%s %s failed for %s", method, collection, label), err.Error())
+			}
+		}
+	}
+	return failed
+}
@@ -0,0 +1,100 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadSeedRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		want    []map[string]any
+		wantErr string
+	}{
+		{
+			name:    "json",
+			format:  "json",
+			content: `[{"code":"a","id":9007199254740993},{"code":"b","tags":["x"]}]`,
+			want: []map[string]any{
+				{"code": "a", "id": json.Number("9007199254740993")},
+				{"code": "b", "tags": []any{"x"}},
+			},
+		},
+		{
+			name:    "json not an array",
+			format:  "json",
+			content: `{"code":"a"}`,
+			wantErr: "parse json",
+		},
+		{
+			name:    "ndjson skips blank lines",
+			format:  "ndjson",
+			content: "{\"code\":\"a\",\"qty\":1.5}\n\n  \n{\"code\":\"b\"}\n",
+			want: []map[string]any{
+				{"code": "a", "qty": json.Number("1.5")},
+				{"code": "b"},
+			},
+		},
+		{
+			name:    "ndjson reports the line",
+			format:  "ndjson",
+			content: "{\"code\":\"a\"}\n{broken\n",
+			wantErr: "parse line 2",
+		},
+		{
+			name:    "csv empty cells are null",
+			format:  "csv",
+			content: "code,name\na,Alpha\nb,\n",
+			want: []map[string]any{
+				{"code": "a", "name": "Alpha"},
+				{"code": "b", "name": nil},
+			},
+		},
+		{
+			name:    "csv header only",
+			format:  "csv",
+			content: "code,name\n",
+			want:    nil,
+		},
+		{
+			name:    "csv without header",
+			format:  "csv",
+			content: "",
+			wantErr: "parse csv header",
+		},
+		{
+			name:    "csv ragged row",
+			format:  "csv",
+			content: "code,name\na\n",
+			wantErr: "parse csv",
+		},
+		{
+			name:    "unsupported format",
+			format:  "xml",
+			content: "<rows/>",
+			wantErr: `unsupported format "xml"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readSeedRows([]byte(tt.content), tt.format)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = jsonType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+)
+
+// jsonType is a string type holding a JSON document. Values are compared
+// semantically, so whitespace and key order don't produce diffs.
+type jsonType struct{ basetypes.StringType }
+
+func (t jsonType) String() string { return "jsonType" }
+
+func (t jsonType) ValueType(_ context.Context) attr.Value { return jsonValue{} }
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonValue{StringValue: in}, nil
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return jsonValue{StringValue: stringValue}, nil
+}
+
+// jsonValue is the value of a jsonType attribute
+type jsonValue struct{ basetypes.StringValue }
+
+func jsonNull() jsonValue { return jsonValue{StringValue: basetypes.NewStringNull()} }
+
+func (v jsonValue) Type(_ context.Context) attr.Type { return jsonType{} }
+
+func (v jsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON
+func (v jsonValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jsonValue)
+	if !ok {
+		diags.AddError("semantic equality check error", fmt.Sprintf("unexpected value type %T", newValuable))
+		return false, diags
+	}
+
+	a, err := decodeExact(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	b, err := decodeExact(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(a, b), diags
+}
+
+// decodeExact decodes a JSON document without rounding numbers through
+// float64. Numbers are normalized so that e.g. 1, 1.0 and 1e0 compare equal.
+func decodeExact(s string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return normalizeNumbers(v), nil
+}
+
+// exactNumber is a normalized JSON number, kept apart from JSON strings
+type exactNumber string
+
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(t.String()); ok {
+			return exactNumber(r.RatString())
+		}
+		return exactNumber(t.String())
+	case map[string]any:
+		for k, e := range t {
+			t[k] = normalizeNumbers(e)
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = normalizeNumbers(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,42 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONValueSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "identical", a: `{"a":1}`, b: `{"a":1}`, want: true},
+		{name: "whitespace and key order", a: `{"a":1,"b":[true,null]}`, b: "{ \"b\": [true, null],\n \"a\": 1 }", want: true},
+		{name: "different value", a: `{"a":1}`, b: `{"a":2}`, want: false},
+		{name: "different keys", a: `{"a":1}`, b: `{"a":1,"b":1}`, want: false},
+		{name: "array order", a: `[1,2]`, b: `[2,1]`, want: false},
+		{name: "large integers differ", a: `{"id":9007199254740993}`, b: `{"id":9007199254740992}`, want: false},
+		{name: "large integers equal", a: `{"id":9007199254740993}`, b: `{"id":9007199254740993}`, want: true},
+		{name: "number notations", a: `[1,0.5,100]`, b: `[1.0,5e-1,1E2]`, want: true},
+		{name: "number is not a string", a: `{"a":1}`, b: `{"a":"1"}`, want: false},
+		{name: "invalid json", a: `{"a":1}`, b: `{"a":`, want: false},
+		{name: "trailing data", a: `{"a":1}`, b: `{"a":1}{}`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := jsonValue{StringValue: types.StringValue(tt.a)}
+			b := jsonValue{StringValue: types.StringValue(tt.b)}
+			got, diags := a.StringSemanticEquals(context.Background(), b)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals(%s, %s) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
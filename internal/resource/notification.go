@@ -0,0 +1,220 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &NotificationResource{}
+
+// NotificationResource implements the directus_notification resource.
+// Notifications are create-only: any change replaces them.
+type NotificationResource struct{ client *client.Directus }
+
+// NotificationModel represents the notification resource model
+type NotificationModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Recipient        types.String `tfsdk:"recipient"`
+	Subject          types.String `tfsdk:"subject"`
+	Message          types.String `tfsdk:"message"`
+	Collection       types.String `tfsdk:"collection"`
+	Item             types.String `tfsdk:"item"`
+	Sender           types.String `tfsdk:"sender"`
+	Status           types.String `tfsdk:"status"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	ArchiveOnDestroy types.Bool   `tfsdk:"archive_on_destroy"`
+}
+
+// NewNotificationResource returns a new notification resource
+func NewNotificationResource() resource.Resource { return &NotificationResource{} }
+
+// Metadata returns the resource type name
+func (r *NotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+// Schema defines the schema for the resource
+func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":         rschema.Int64Attribute{Computed: true},
+			"recipient":  rschema.StringAttribute{Required: true, PlanModifiers: replace, Description: "ID of the user to notify"},
+			"subject":    rschema.StringAttribute{Required: true, PlanModifiers: replace},
+			"message":    rschema.StringAttribute{Optional: true, PlanModifiers: replace, Description: "markdown body"},
+			"collection": rschema.StringAttribute{Optional: true, PlanModifiers: replace},
+			"item":       rschema.StringAttribute{Optional: true, PlanModifiers: replace},
+			"sender":     rschema.StringAttribute{Computed: true},
+			"status":     rschema.StringAttribute{Computed: true, Description: "inbox or archived"},
+			"timestamp":  rschema.StringAttribute{Computed: true},
+			"archive_on_destroy": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Archive the notification on destroy. When false, destroy only removes it from the state and leaves the notification in Directus.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *NotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create sends the notification
+func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{
+		"recipient": plan.Recipient.ValueString(),
+		"subject":   plan.Subject.ValueString(),
+	}
+	if v := plan.Message.ValueString(); v != "" {
+		payload["message"] = v
+	}
+	if v := plan.Collection.ValueString(); v != "" {
+		payload["collection"] = v
+	}
+	if v := plan.Item.ValueString(); v != "" {
+		payload["item"] = v
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/notifications", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the notification
+func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/notifications/"+strconv.FormatInt(state.ID.ValueInt64(), 10), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records a change of archive_on_destroy; everything else forces
+// a replacement.
+func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationModel
+	var state NotificationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ArchiveOnDestroy = plan.ArchiveOnDestroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete archives the notification when archive_on_destroy is set, otherwise
+// it only drops it from the state
+func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without archiving, notifications are left as they are
+	if !state.ArchiveOnDestroy.ValueBool() {
+		return
+	}
+
+	url := "/notifications/" + strconv.FormatInt(state.ID.ValueInt64(), 10)
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, url, map[string]any{"status": "archived"})
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_notification
+func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Notification ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("archive_on_destroy"), false)...)
+}
+
+// helper: map API data into the model
+func (r *NotificationResource) readIntoState(nm *NotificationModel, data map[string]any) {
+	nm.ID = int64PtrToType(data["id"])
+	nm.Recipient = types.StringValue(str(data["recipient"]))
+	nm.Subject = types.StringValue(str(data["subject"]))
+	nm.Message = strPtrToType(data["message"])
+	nm.Collection = strPtrToType(data["collection"])
+	nm.Item = strPtrToType(data["item"])
+	nm.Sender = strPtrToType(data["sender"])
+	nm.Status = strPtrToType(data["status"])
+	nm.Timestamp = strPtrToType(data["timestamp"])
+}
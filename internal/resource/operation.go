@@ -0,0 +1,276 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &OperationResource{}
+
+// OperationResource implements the directus_operation resource
+type OperationResource struct{ client *client.Directus }
+
+// OperationModel represents the operation resource model
+type OperationModel struct {
+	ID             types.String `tfsdk:"id"`
+	Flow           types.String `tfsdk:"flow"`
+	Key            types.String `tfsdk:"key"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	PositionX      types.Int64  `tfsdk:"position_x"`
+	PositionY      types.Int64  `tfsdk:"position_y"`
+	Options        types.String `tfsdk:"options"` // JSON string
+	Resolve        types.String `tfsdk:"resolve"` // operation id
+	Reject         types.String `tfsdk:"reject"`  // operation id
+	FirstOperation types.Bool   `tfsdk:"first_operation"`
+}
+
+// NewOperationResource returns a new operation resource
+func NewOperationResource() resource.Resource { return &OperationResource{} }
+
+// Metadata returns the resource type name
+func (r *OperationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation"
+}
+
+// Schema defines the schema for the resource
+func (r *OperationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true},
+			"flow": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"key":        rschema.StringAttribute{Required: true},
+			"name":       rschema.StringAttribute{Optional: true},
+			"type":       rschema.StringAttribute{Required: true, Description: "operation type, e.g. log, request, item-create"},
+			"position_x": rschema.Int64Attribute{Optional: true, Computed: true},
+			"position_y": rschema.Int64Attribute{Optional: true, Computed: true},
+			"options":    rschema.StringAttribute{Optional: true, Description: "JSON string of operation options"},
+			"resolve":    rschema.StringAttribute{Optional: true, Description: "ID of the operation run on success"},
+			"reject":     rschema.StringAttribute{Optional: true, Description: "ID of the operation run on failure"},
+			"first_operation": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Attach this operation to the flow's trigger. Don't combine with the flow's inline operations.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *OperationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new operation
+func (r *OperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OperationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := r.payload(plan)
+	if _, ok := payload["position_x"]; !ok {
+		payload["position_x"] = 19
+	}
+	if _, ok := payload["position_y"]; !ok {
+		payload["position_y"] = 1
+	}
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/operations", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(str(apiResp.Data["id"]))
+
+	if plan.FirstOperation.ValueBool() {
+		if err := setFlowOperation(ctx, r.client, plan.Flow.ValueString(), plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the operation
+func (r *OperationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OperationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refreshState(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the operation
+func (r *OperationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OperationModel
+	var state OperationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/operations/"+plan.ID.ValueString(), r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	if !plan.FirstOperation.IsNull() && !plan.FirstOperation.IsUnknown() &&
+		plan.FirstOperation.ValueBool() != state.FirstOperation.ValueBool() {
+		id := ""
+		if plan.FirstOperation.ValueBool() {
+			id = plan.ID.ValueString()
+		}
+		if err := setFlowOperation(ctx, r.client, plan.Flow.ValueString(), id); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	if !r.refreshState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the operation
+func (r *OperationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OperationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/operations/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_operation
+func (r *OperationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Helper functions
+
+func (r *OperationResource) payload(plan OperationModel) map[string]any {
+	payload := map[string]any{
+		"flow":    plan.Flow.ValueString(),
+		"key":     plan.Key.ValueString(),
+		"type":    plan.Type.ValueString(),
+		"name":    plan.Key.ValueString(),
+		"options": nil,
+		"resolve": nullableStr(plan.Resolve),
+		"reject":  nullableStr(plan.Reject),
+	}
+	if v := plan.Name.ValueString(); v != "" {
+		payload["name"] = v
+	}
+	if s := plan.Options.ValueString(); s != "" {
+		payload["options"] = jsonRaw(s)
+	}
+	if !plan.PositionX.IsNull() && !plan.PositionX.IsUnknown() {
+		payload["position_x"] = plan.PositionX.ValueInt64()
+	}
+	if !plan.PositionY.IsNull() && !plan.PositionY.IsUnknown() {
+		payload["position_y"] = plan.PositionY.ValueInt64()
+	}
+	return payload
+}
+
+// refreshState reads the operation into om. It returns false if the operation
+// no longer exists or the request failed.
+func (r *OperationResource) refreshState(ctx context.Context, om *OperationModel, diags diagCollector) bool {
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/operations/"+om.ID.ValueString()+"?fields=*,flow.id,flow.operation", nil)
+	if err != nil {
+		diags.AddError("api error", err.Error())
+		return false
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			return false
+		}
+		diags.AddError("api error", err.Error())
+		return false
+	}
+
+	data := apiResp.Data
+	om.ID = types.StringValue(str(data["id"]))
+	om.Key = types.StringValue(str(data["key"]))
+	om.Type = types.StringValue(str(data["type"]))
+	om.PositionX = int64PtrToType(data["position_x"])
+	om.PositionY = int64PtrToType(data["position_y"])
+	om.Options = jsonStateValue(om.Options, data["options"])
+	om.Resolve = strPtrToType(data["resolve"])
+	om.Reject = strPtrToType(data["reject"])
+
+	// The name defaults to the key when not configured
+	name := strPtrToType(data["name"])
+	if !(om.Name.IsNull() && name.ValueString() == om.Key.ValueString()) {
+		om.Name = name
+	}
+
+	om.FirstOperation = types.BoolValue(false)
+	if flow, ok := data["flow"].(map[string]any); ok {
+		om.Flow = types.StringValue(str(flow["id"]))
+		om.FirstOperation = types.BoolValue(str(flow["operation"]) == om.ID.ValueString())
+	}
+
+	return true
+}
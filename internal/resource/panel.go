@@ -0,0 +1,226 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &PanelResource{}
+
+// PanelResource implements the directus_panel resource
+type PanelResource struct{ client *client.Directus }
+
+// PanelModel represents the panel resource model
+type PanelModel struct {
+	ID         types.String `tfsdk:"id"`
+	Dashboard  types.String `tfsdk:"dashboard"`
+	Type       types.String `tfsdk:"type"`
+	PositionX  types.Int64  `tfsdk:"position_x"`
+	PositionY  types.Int64  `tfsdk:"position_y"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	Options    types.String `tfsdk:"options"` // JSON string
+	ShowHeader types.Bool   `tfsdk:"show_header"`
+	Name       types.String `tfsdk:"name"`
+	Icon       types.String `tfsdk:"icon"`
+	Note       types.String `tfsdk:"note"`
+}
+
+// NewPanelResource returns a new panel resource
+func NewPanelResource() resource.Resource { return &PanelResource{} }
+
+// Metadata returns the resource type name
+func (r *PanelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_panel"
+}
+
+// Schema defines the schema for the resource
+func (r *PanelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":          rschema.StringAttribute{Computed: true},
+			"dashboard":   rschema.StringAttribute{Required: true},
+			"type":        rschema.StringAttribute{Required: true, Description: "panel type, e.g. metric, list, time-series, label"},
+			"position_x":  rschema.Int64Attribute{Required: true},
+			"position_y":  rschema.Int64Attribute{Required: true},
+			"width":       rschema.Int64Attribute{Required: true},
+			"height":      rschema.Int64Attribute{Required: true},
+			"options":     rschema.StringAttribute{Optional: true, Description: "JSON string of panel options"},
+			"show_header": rschema.BoolAttribute{Optional: true, Computed: true},
+			"name":        rschema.StringAttribute{Optional: true},
+			"icon":        rschema.StringAttribute{Optional: true, Computed: true},
+			"note":        rschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *PanelResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates a new panel
+func (r *PanelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PanelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/panels", r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the panel
+func (r *PanelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PanelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/panels/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the panel
+func (r *PanelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PanelModel
+	var state PanelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/panels/"+plan.ID.ValueString(), r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the panel
+func (r *PanelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PanelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/panels/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_panel
+func (r *PanelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Helper functions
+
+func (r *PanelResource) payload(plan PanelModel) map[string]any {
+	payload := map[string]any{
+		"dashboard":  plan.Dashboard.ValueString(),
+		"type":       plan.Type.ValueString(),
+		"position_x": plan.PositionX.ValueInt64(),
+		"position_y": plan.PositionY.ValueInt64(),
+		"width":      plan.Width.ValueInt64(),
+		"height":     plan.Height.ValueInt64(),
+		"name":       nullableStr(plan.Name),
+		"note":       nullableStr(plan.Note),
+		"options":    nil,
+	}
+	if s := plan.Options.ValueString(); s != "" {
+		payload["options"] = jsonRaw(s)
+	}
+	if !plan.ShowHeader.IsNull() && !plan.ShowHeader.IsUnknown() {
+		payload["show_header"] = plan.ShowHeader.ValueBool()
+	}
+	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
+		payload["icon"] = plan.Icon.ValueString()
+	}
+	return payload
+}
+
+// helper: map API data into the model
+func (r *PanelResource) readIntoState(pm *PanelModel, data map[string]any) {
+	pm.ID = types.StringValue(str(data["id"]))
+	pm.Dashboard = types.StringValue(str(data["dashboard"]))
+	pm.Type = types.StringValue(str(data["type"]))
+	pm.PositionX = int64PtrToType(data["position_x"])
+	pm.PositionY = int64PtrToType(data["position_y"])
+	pm.Width = int64PtrToType(data["width"])
+	pm.Height = int64PtrToType(data["height"])
+	pm.Options = jsonStateValue(pm.Options, data["options"])
+	pm.ShowHeader = types.BoolValue(boolVal(data["show_header"]))
+	pm.Name = strPtrToType(data["name"])
+	pm.Icon = strPtrToType(data["icon"])
+	pm.Note = strPtrToType(data["note"])
+}
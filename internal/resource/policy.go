@@ -8,6 +8,7 @@ import (
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
 )
 
 // PolicyResource implements the directus_policy resource
@@ -211,11 +212,8 @@ func (r *PolicyResource) readIntoState(ctx context.Context, plan *PolicyModel, r
 	}
 }
 
-func boolPtrToType(any any) types.Bool {
-	if b, ok := any.(bool); ok {
-		return types.BoolValue(b)
-	}
-	return types.BoolNull()
+func boolPtrToType(v any) types.Bool {
+	return convert.BoolPtrToType(v)
 }
 
 // utility funcs
@@ -0,0 +1,263 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &PresetResource{}
+	_ resource.ResourceWithValidateConfig = &PresetResource{}
+)
+
+// PresetResource implements the directus_preset resource
+type PresetResource struct{ client *client.Directus }
+
+// PresetModel represents the preset resource model
+type PresetModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Bookmark        types.String `tfsdk:"bookmark"`
+	User            types.String `tfsdk:"user"`
+	Role            types.String `tfsdk:"role"`
+	Collection      types.String `tfsdk:"collection"`
+	Search          types.String `tfsdk:"search"`
+	Filter          types.String `tfsdk:"filter"` // JSON string
+	Layout          types.String `tfsdk:"layout"`
+	LayoutQuery     types.String `tfsdk:"layout_query"`   // JSON string
+	LayoutOptions   types.String `tfsdk:"layout_options"` // JSON string
+	RefreshInterval types.Int64  `tfsdk:"refresh_interval"`
+	Icon            types.String `tfsdk:"icon"`
+	Color           types.String `tfsdk:"color"`
+}
+
+// NewPresetResource returns a new preset resource
+func NewPresetResource() resource.Resource { return &PresetResource{} }
+
+// Metadata returns the resource type name
+func (r *PresetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preset"
+}
+
+// Schema defines the schema for the resource
+func (r *PresetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":               rschema.Int64Attribute{Computed: true},
+			"bookmark":         rschema.StringAttribute{Optional: true, Description: "bookmark title; leave empty for a default layout preset"},
+			"user":             rschema.StringAttribute{Optional: true, Description: "user the preset applies to; conflicts with role"},
+			"role":             rschema.StringAttribute{Optional: true, Description: "role the preset applies to; conflicts with user"},
+			"collection":       rschema.StringAttribute{Required: true},
+			"search":           rschema.StringAttribute{Optional: true},
+			"filter":           rschema.StringAttribute{Optional: true, Description: "JSON string of the filter"},
+			"layout":           rschema.StringAttribute{Optional: true, Description: "e.g. tabular, cards, calendar"},
+			"layout_query":     rschema.StringAttribute{Optional: true, Description: "JSON string of the layout query"},
+			"layout_options":   rschema.StringAttribute{Optional: true, Description: "JSON string of the layout options"},
+			"refresh_interval": rschema.Int64Attribute{Optional: true, Description: "auto refresh interval in seconds"},
+			"icon":             rschema.StringAttribute{Optional: true, Computed: true},
+			"color":            rschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *PresetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures a preset targets a user or a role, not both
+func (r *PresetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg PresetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !cfg.User.IsNull() && !cfg.Role.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "conflicting attributes", "Only one of `user` or `role` can be set.")
+	}
+}
+
+// Create creates a new preset
+func (r *PresetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PresetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/presets", r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the preset
+func (r *PresetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PresetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/presets/"+strconv.FormatInt(state.ID.ValueInt64(), 10), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the preset
+func (r *PresetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PresetModel
+	var state PresetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/presets/"+strconv.FormatInt(plan.ID.ValueInt64(), 10), r.payload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the preset
+func (r *PresetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PresetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/presets/"+strconv.FormatInt(state.ID.ValueInt64(), 10), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_preset
+func (r *PresetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Preset ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Helper functions
+
+func (r *PresetResource) payload(plan PresetModel) map[string]any {
+	payload := map[string]any{
+		"collection":       plan.Collection.ValueString(),
+		"bookmark":         nullableStr(plan.Bookmark),
+		"user":             nullableStr(plan.User),
+		"role":             nullableStr(plan.Role),
+		"search":           nullableStr(plan.Search),
+		"layout":           nullableStr(plan.Layout),
+		"color":            nullableStr(plan.Color),
+		"filter":           nil,
+		"layout_query":     nil,
+		"layout_options":   nil,
+		"refresh_interval": nil,
+	}
+	if s := plan.Filter.ValueString(); s != "" {
+		payload["filter"] = jsonRaw(s)
+	}
+	if s := plan.LayoutQuery.ValueString(); s != "" {
+		payload["layout_query"] = jsonRaw(s)
+	}
+	if s := plan.LayoutOptions.ValueString(); s != "" {
+		payload["layout_options"] = jsonRaw(s)
+	}
+	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
+		payload["icon"] = plan.Icon.ValueString()
+	}
+	if !plan.RefreshInterval.IsNull() {
+		payload["refresh_interval"] = plan.RefreshInterval.ValueInt64()
+	}
+	return payload
+}
+
+// helper: map API data into the model
+func (r *PresetResource) readIntoState(pm *PresetModel, data map[string]any) {
+	pm.ID = int64PtrToType(data["id"])
+	pm.Bookmark = strPtrToType(data["bookmark"])
+	pm.User = strPtrToType(data["user"])
+	pm.Role = strPtrToType(data["role"])
+	pm.Collection = types.StringValue(str(data["collection"]))
+	pm.Search = strPtrToType(data["search"])
+	pm.Filter = jsonStateValue(pm.Filter, data["filter"])
+	pm.Layout = strPtrToType(data["layout"])
+	pm.LayoutQuery = jsonStateValue(pm.LayoutQuery, data["layout_query"])
+	pm.LayoutOptions = jsonStateValue(pm.LayoutOptions, data["layout_options"])
+	pm.RefreshInterval = int64PtrToType(data["refresh_interval"])
+	pm.Icon = strPtrToType(data["icon"])
+	pm.Color = strPtrToType(data["color"])
+}
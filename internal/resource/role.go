@@ -5,17 +5,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/convert"
 )
 
-var _ resource.ResourceWithImportState = &RoleResource{}
+var (
+	_ resource.ResourceWithImportState = &RoleResource{}
+	_ resource.ResourceWithModifyPlan  = &RoleResource{}
+)
 
 // RoleResource implements the directus_role resource
 type RoleResource struct{ client *client.Directus }
@@ -26,9 +33,9 @@ type RoleModel struct {
 	Name        types.String `tfsdk:"name"`
 	Icon        types.String `tfsdk:"icon"`
 	Description types.String `tfsdk:"description"`
-	// Parent      types.String `tfsdk:"parent"`
-	// Children    types.List   `tfsdk:"children"`
-	Policies types.Set `tfsdk:"policies"`
+	Parent      types.String `tfsdk:"parent"`
+	Children    types.Set    `tfsdk:"children"`
+	Policies    types.Set    `tfsdk:"policies"`
 }
 
 // NewRoleResource returns a new role resource
@@ -41,12 +48,20 @@ func (r *RoleResource) Metadata(_ context.Context, req resource.MetadataRequest,
 func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Attributes: map[string]rschema.Attribute{
-			"id":          rschema.StringAttribute{Computed: true},
-			"name":        rschema.StringAttribute{Required: true},
-			"icon":        rschema.StringAttribute{Optional: true},
+			"id":   rschema.StringAttribute{Computed: true},
+			"name": rschema.StringAttribute{Required: true},
+			"icon": rschema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 			"description": rschema.StringAttribute{Optional: true},
-			// "parent":      rschema.StringAttribute{Optional: true},
-			// "children":    rschema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"parent":      rschema.StringAttribute{Optional: true, Description: "ID of the parent role"},
+			"children": rschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the roles nested under this role",
+			},
 			"policies": rschema.SetAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -73,15 +88,15 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	payload := map[string]any{
 		"name": plan.Name.ValueString(),
 	}
-	if !plan.Icon.IsNull() {
-		payload["icon"] = plan.Icon.ValueString()
+	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
+		payload["icon"] = nullableStr(plan.Icon)
 	}
 	if !plan.Description.IsNull() {
-		payload["description"] = plan.Description.ValueString()
+		payload["description"] = nullableStr(plan.Description)
+	}
+	if !plan.Parent.IsNull() {
+		payload["parent"] = plan.Parent.ValueString()
 	}
-	// if !plan.Parent.IsNull() {
-	// 	payload["parent"] = plan.Parent.ValueString()
-	// }
 
 	apiResp := struct {
 		Data map[string]any `json:"data"`
@@ -163,9 +178,11 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// ---- 1. Update role fields ----
 	payload := map[string]any{
 		"name":        plan.Name.ValueString(),
-		"icon":        nullableStr(plan.Icon),
 		"description": nullableStr(plan.Description),
-		// "parent":      nullableStr(plan.Parent),
+		"parent":      nullableStr(plan.Parent),
+	}
+	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
+		payload["icon"] = nullableStr(plan.Icon)
 	}
 
 	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/roles/"+plan.ID.ValueString(), payload)
@@ -183,7 +200,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		// desired from plan
 		var desired []string
 		if err := plan.Policies.ElementsAs(ctx, &desired, false); err != nil {
-			resp.Diagnostics.AddError("plan error", fmt.Sprintf("failed to parse policies from plan: %w", err))
+			resp.Diagnostics.AddError("plan error", fmt.Sprintf("failed to parse policies from plan: %v", err))
 			return
 		}
 
@@ -264,6 +281,13 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	// Refresh from API to pick up computed children
+	if !r.refreshState(ctx, plan.ID.ValueString(), &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("api error", "role "+plan.ID.ValueString()+" disappeared during update")
+		}
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -285,9 +309,63 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ModifyPlan rejects a parent that would make the role its own ancestor
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Roles that don't exist yet can't be anyone's ancestor
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RoleModel
+	var state RoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Parent.IsNull() || plan.Parent.IsUnknown() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	seen := map[string]struct{}{}
+	for ancestor := plan.Parent.ValueString(); ancestor != ""; {
+		if ancestor == id {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent"),
+				"role hierarchy cycle",
+				fmt.Sprintf("Role %s can't be nested under %s: it is already one of its ancestors.", id, plan.Parent.ValueString()),
+			)
+			return
+		}
+		if _, ok := seen[ancestor]; ok {
+			return
+		}
+		seen[ancestor] = struct{}{}
+
+		apiResp := struct {
+			Data map[string]any `json:"data"`
+		}{}
+		httpResp, err := r.client.Request(ctx, http.MethodGet, "/roles/"+ancestor+"?fields=parent", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		err = parseResp(httpResp, &apiResp)
+		httpResp.Body.Close()
+		if err != nil {
+			if isNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		ancestor = str(apiResp.Data["parent"])
+	}
+}
+
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idStr := req.ID
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idStr)...)
+	// An empty set marks policies as managed so the read fills them in
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policies"), types.SetValueMust(types.StringType, []attr.Value{}))...)
 }
 
 // Helper functions
@@ -310,9 +388,25 @@ func (r *RoleResource) refreshState(ctx context.Context, id string, rm *RoleMode
 	}
 
 	rm.Name = types.StringValue(str(apiResp.Data["name"]))
-	rm.Icon = types.StringValue(str(apiResp.Data["icon"]))
-	rm.Description = types.StringValue(str(apiResp.Data["description"]))
-	// rm.Parent = types.StringValue(str(apiResp.Data["parent"]))
+	rm.Icon = emptyOrStr(rm.Icon, apiResp.Data["icon"])
+	rm.Description = emptyOrStr(rm.Description, apiResp.Data["description"])
+	rm.Parent = strPtrToType(apiResp.Data["parent"])
+
+	children := []attr.Value{}
+	if v, ok := apiResp.Data["children"].([]any); ok {
+		for _, c := range v {
+			if id := str(c); id != "" {
+				children = append(children, types.StringValue(id))
+			}
+		}
+	}
+	rm.Children, _ = types.SetValue(types.StringType, children)
+
+	// Leave policies unmanaged when not configured, so they can be attached
+	// through directus_access instead. Imported roles start with an empty set.
+	if rm.Policies.IsNull() {
+		return true
+	}
 
 	if v, ok := apiResp.Data["policies"].([]any); ok {
 		seen := make(map[string]struct{})
@@ -349,25 +443,20 @@ func isNotFound(err error) bool {
 }
 
 func str(v any) string {
-	switch t := v.(type) {
-	case string:
-		return t
-	case nil:
-		return ""
-	default:
-		return fmt.Sprintf("%v", t)
-	}
+	return convert.Str(v)
 }
 
 func boolVal(v any) bool {
-	switch t := v.(type) {
-	case bool:
-		return t
-	case nil:
-		return false
-	default:
-		return false
+	return convert.BoolVal(v)
+}
+
+// emptyOrStr reads a string stored by nullableStr, keeping a configured empty
+// string that the API returns as null
+func emptyOrStr(prior types.String, v any) types.String {
+	if next := strPtrToType(v); !next.IsNull() || prior.IsNull() || prior.IsUnknown() || prior.ValueString() != "" {
+		return next
 	}
+	return prior
 }
 
 func nullableStr(v types.String) any {
@@ -392,15 +481,18 @@ func (r rawJSON) MarshalJSON() ([]byte, error) {
 
 func jsonRaw(s string) rawJSON { return rawJSON(s) }
 
-func strPtrToType(v any) types.String {
+// jsonStateValue converts a JSON value returned by the API into a string
+// attribute, keeping the prior string when it is semantically equal so that
+// formatting differences don't show up as drift.
+func jsonStateValue(prior types.String, v any) types.String {
 	if v == nil {
 		return types.StringNull()
 	}
-	if s, ok := v.(string); ok {
-		if s == "" {
-			return types.StringNull()
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var p any
+		if err := json.Unmarshal([]byte(prior.ValueString()), &p); err == nil && reflect.DeepEqual(p, v) {
+			return prior
 		}
-		return types.StringValue(s)
 	}
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -409,15 +501,14 @@ func strPtrToType(v any) types.String {
 	return types.StringValue(string(b))
 }
 
+func int64PtrToType(v any) types.Int64 {
+	return convert.Int64PtrToType(v)
+}
+
+func strPtrToType(v any) types.String {
+	return convert.StrPtrToType(v)
+}
+
 func parseResp(resp *http.Response, out any) error {
-	// defer resp.Body.Close()
-	if resp.StatusCode >= 300 {
-		var apiErr map[string]any
-		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
-		return fmt.Errorf("directus api %d: %v", resp.StatusCode, apiErr)
-	}
-	if out == nil {
-		return nil
-	}
-	return json.NewDecoder(resp.Body).Decode(out)
+	return client.ParseResponse(resp, out)
 }
@@ -0,0 +1,365 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &RoleUsersResource{}
+
+// RoleUsersResource implements the directus_role_users resource, managing
+// which users are assigned to a role
+type RoleUsersResource struct{ client *client.Directus }
+
+// RoleUsersModel represents the role users resource model
+type RoleUsersModel struct {
+	ID            types.String `tfsdk:"id"` // same as role
+	Role          types.String `tfsdk:"role"`
+	Users         types.Set    `tfsdk:"users"` // user IDs or emails
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	UserIDs       types.Set    `tfsdk:"user_ids"`
+}
+
+// NewRoleUsersResource returns a new role users resource
+func NewRoleUsersResource() resource.Resource { return &RoleUsersResource{} }
+
+// Metadata returns the resource type name
+func (r *RoleUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_users"
+}
+
+// Schema defines the schema for the resource
+func (r *RoleUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true},
+			"role": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"users": rschema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs or emails of the users assigned to the role",
+			},
+			"authoritative": rschema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "Remove the role from users that aren't listed. When false, only users listed " +
+					"here, or removed from the list, are touched.",
+			},
+			"user_ids": rschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of every user currently assigned to the role",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *RoleUsersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create assigns the role to the listed users
+func (r *RoleUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleUsersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan, desired, nil); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	plan.ID = plan.Role
+	if err := r.refreshState(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the role's members with the listed users
+func (r *RoleUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refreshState(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update reassigns the role's members
+func (r *RoleUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoleUsersModel
+	var state RoleUsersModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired, previous []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &desired, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan, desired, previous); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	if err := r.refreshState(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the role from the listed users
+func (r *RoleUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconciling against an empty, non-authoritative list only clears the listed users
+	state.Authoritative = types.BoolValue(false)
+	if err := r.reconcile(ctx, state, nil, previous); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState imports the current members of a role by role ID
+func (r *RoleUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), types.SetValueMust(types.StringType, []attr.Value{}))...)
+}
+
+// Helper functions
+
+// roleMember is a user currently assigned to the role
+type roleMember struct{ id, email string }
+
+func (r *RoleUsersResource) members(ctx context.Context, role string) ([]roleMember, error) {
+	filter, err := json.Marshal(map[string]any{"role": map[string]any{"_eq": role}})
+	if err != nil {
+		return nil, err
+	}
+	users, err := r.client.GetAll(ctx, "/users", url.Values{
+		"filter": {string(filter)},
+		"fields": {"id,email"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	members := make([]roleMember, 0, len(users))
+	for _, u := range users {
+		members = append(members, roleMember{id: str(u["id"]), email: strings.ToLower(str(u["email"]))})
+	}
+	return members, nil
+}
+
+// resolve maps every entry to a user ID, looking up the ones that are emails
+func (r *RoleUsersResource) resolve(ctx context.Context, entries []string) (map[string]string, error) {
+	ids := make(map[string]string, len(entries))
+	var emails []string
+	for _, e := range entries {
+		if strings.Contains(e, "@") {
+			emails = append(emails, strings.ToLower(e))
+		} else {
+			ids[e] = e
+		}
+	}
+	if len(emails) == 0 {
+		return ids, nil
+	}
+
+	filter, err := json.Marshal(map[string]any{"email": map[string]any{"_in": emails}})
+	if err != nil {
+		return nil, err
+	}
+	users, err := r.client.GetAll(ctx, "/users", url.Values{
+		"filter": {string(filter)},
+		"fields": {"id,email"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	byEmail := make(map[string]string, len(users))
+	for _, u := range users {
+		byEmail[strings.ToLower(str(u["email"]))] = str(u["id"])
+	}
+	for _, e := range entries {
+		if !strings.Contains(e, "@") {
+			continue
+		}
+		id, ok := byEmail[strings.ToLower(e)]
+		if !ok {
+			return nil, fmt.Errorf("no user with email %q", e)
+		}
+		ids[e] = id
+	}
+	return ids, nil
+}
+
+func (r *RoleUsersResource) setRole(ctx context.Context, ids []string, role any) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	payload := map[string]any{"keys": ids, "data": map[string]any{"role": role}}
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/users", payload)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	return parseResp(httpResp, nil)
+}
+
+// reconcile assigns the role to desired users and clears it from the users
+// listed previously but not anymore, or from every other member when
+// authoritative.
+func (r *RoleUsersResource) reconcile(ctx context.Context, m RoleUsersModel, desired, previous []string) error {
+	role := m.Role.ValueString()
+
+	want, err := r.resolve(ctx, desired)
+	if err != nil {
+		return err
+	}
+	members, err := r.members(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]struct{}, len(members))
+	for _, u := range members {
+		current[u.id] = struct{}{}
+	}
+	wanted := make(map[string]struct{}, len(want))
+	for _, id := range want {
+		wanted[id] = struct{}{}
+	}
+
+	var add []string
+	for id := range wanted {
+		if _, ok := current[id]; !ok {
+			add = append(add, id)
+		}
+	}
+
+	var remove []string
+	if m.Authoritative.ValueBool() {
+		for id := range current {
+			if _, ok := wanted[id]; !ok {
+				remove = append(remove, id)
+			}
+		}
+	} else if len(previous) > 0 {
+		// Users that are gone already can't be resolved, so look them up
+		// among the current members only
+		byEmail := make(map[string]string, len(members))
+		for _, u := range members {
+			byEmail[u.email] = u.id
+		}
+		for _, e := range previous {
+			id := e
+			if strings.Contains(e, "@") {
+				id = byEmail[strings.ToLower(e)]
+			}
+			if _, ok := current[id]; !ok {
+				continue
+			}
+			if _, ok := wanted[id]; !ok {
+				remove = append(remove, id)
+			}
+		}
+	}
+
+	if err := r.setRole(ctx, add, role); err != nil {
+		return err
+	}
+	return r.setRole(ctx, remove, nil)
+}
+
+// refreshState keeps the listed users that are still members and, when
+// authoritative, adds the IDs of unlisted members so they show up as drift.
+func (r *RoleUsersResource) refreshState(ctx context.Context, m *RoleUsersModel) error {
+	members, err := r.members(ctx, m.Role.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var entries []string
+	m.Users.ElementsAs(ctx, &entries, false)
+
+	matched := map[string]struct{}{}
+	users := []attr.Value{}
+	for _, e := range entries {
+		for _, u := range members {
+			if e == u.id || strings.EqualFold(e, u.email) {
+				matched[u.id] = struct{}{}
+				users = append(users, types.StringValue(e))
+				break
+			}
+		}
+	}
+
+	ids := make([]attr.Value, 0, len(members))
+	for _, u := range members {
+		ids = append(ids, types.StringValue(u.id))
+		if _, ok := matched[u.id]; !ok && m.Authoritative.ValueBool() {
+			users = append(users, types.StringValue(u.id))
+		}
+	}
+
+	m.Users, _ = types.SetValue(types.StringType, users)
+	m.UserIDs, _ = types.SetValue(types.StringType, ids)
+	return nil
+}
@@ -0,0 +1,280 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &ShareResource{}
+	_ resource.ResourceWithValidateConfig = &ShareResource{}
+)
+
+// ShareResource implements the directus_share resource
+type ShareResource struct{ client *client.Directus }
+
+// ShareModel represents the share resource model
+type ShareModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Collection types.String `tfsdk:"collection"`
+	Item       types.String `tfsdk:"item"`
+	Role       types.String `tfsdk:"role"`
+	Policy     types.String `tfsdk:"policy"`
+	Password   types.String `tfsdk:"password"` // write-only, never read back
+	DateStart  types.String `tfsdk:"date_start"`
+	DateEnd    types.String `tfsdk:"date_end"`
+	MaxUses    types.Int64  `tfsdk:"max_uses"`
+	TimesUsed  types.Int64  `tfsdk:"times_used"`
+	URL        types.String `tfsdk:"url"`
+}
+
+// NewShareResource returns a new share resource
+func NewShareResource() resource.Resource { return &ShareResource{} }
+
+// Metadata returns the resource type name
+func (r *ShareResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_share"
+}
+
+// Schema defines the schema for the resource
+func (r *ShareResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id":   rschema.StringAttribute{Computed: true},
+			"name": rschema.StringAttribute{Optional: true},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"item": rschema.StringAttribute{
+				Required:      true,
+				Description:   "primary key of the shared item",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"role":       rschema.StringAttribute{Optional: true, Description: "role whose permissions apply to the share; conflicts with policy"},
+			"policy":     rschema.StringAttribute{Optional: true, Description: "policy whose permissions apply to the share (Directus 11); conflicts with role"},
+			"password":   rschema.StringAttribute{Optional: true, Sensitive: true, Description: "write-only; changes made outside Terraform are not detected"},
+			"date_start": rschema.StringAttribute{Optional: true, Description: "RFC 3339 timestamp from which the share is valid"},
+			"date_end":   rschema.StringAttribute{Optional: true, Description: "RFC 3339 timestamp after which the share expires"},
+			"max_uses":   rschema.Int64Attribute{Optional: true},
+			"times_used": rschema.Int64Attribute{Computed: true},
+			"url":        rschema.StringAttribute{Computed: true, Description: "public link to the shared item"},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *ShareResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// ValidateConfig ensures the share uses a role or a policy, not both
+func (r *ShareResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg ShareModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !cfg.Role.IsNull() && !cfg.Policy.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("policy"), "conflicting attributes", "Only one of `role` or `policy` can be set.")
+	}
+}
+
+// Create creates a new share
+func (r *ShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ShareModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := r.payload(plan)
+	for k, v := range payload {
+		if v == nil {
+			delete(payload, k)
+		}
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPost, "/shares", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the share
+func (r *ShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ShareModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/shares/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&state, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the share
+func (r *ShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ShareModel
+	var state ShareModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	payload := r.payload(plan)
+	// Only send the password when it changes so the stored hash isn't reset
+	if plan.Password.Equal(state.Password) {
+		delete(payload, "password")
+	}
+	// Directus 10 has no policy on shares
+	if plan.Policy.IsNull() && state.Policy.IsNull() {
+		delete(payload, "policy")
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/shares/"+plan.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	r.readIntoState(&plan, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the share
+func (r *ShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ShareModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodDelete, "/shares/"+state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := parseResp(httpResp, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState allows terraform import support for directus_share
+func (r *ShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Helper functions
+
+func (r *ShareResource) payload(plan ShareModel) map[string]any {
+	payload := map[string]any{
+		"collection": plan.Collection.ValueString(),
+		"item":       plan.Item.ValueString(),
+		"name":       nullableStr(plan.Name),
+		"role":       nullableStr(plan.Role),
+		"policy":     nullableStr(plan.Policy),
+		"password":   nullableStr(plan.Password),
+		"date_start": nullableStr(plan.DateStart),
+		"date_end":   nullableStr(plan.DateEnd),
+		"max_uses":   nil,
+	}
+	if !plan.MaxUses.IsNull() {
+		payload["max_uses"] = plan.MaxUses.ValueInt64()
+	}
+	return payload
+}
+
+// helper: map API data into the model
+func (r *ShareResource) readIntoState(sm *ShareModel, data map[string]any) {
+	sm.ID = types.StringValue(str(data["id"]))
+	sm.Name = strPtrToType(data["name"])
+	sm.Collection = types.StringValue(str(data["collection"]))
+	sm.Item = types.StringValue(str(data["item"]))
+	sm.Role = strPtrToType(data["role"])
+	if _, ok := data["policy"]; ok {
+		sm.Policy = strPtrToType(data["policy"])
+	}
+	sm.DateStart = timeStateValue(sm.DateStart, data["date_start"])
+	sm.DateEnd = timeStateValue(sm.DateEnd, data["date_end"])
+	sm.MaxUses = int64PtrToType(data["max_uses"])
+	sm.TimesUsed = int64PtrToType(data["times_used"])
+	sm.URL = types.StringValue(r.client.BaseURL() + "/admin/shared/" + sm.ID.ValueString())
+}
+
+// timeStateValue converts a timestamp returned by the API into a string
+// attribute, keeping the prior value when it denotes the same instant.
+func timeStateValue(prior types.String, v any) types.String {
+	s := str(v)
+	if s == "" {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		p, errP := time.Parse(time.RFC3339, prior.ValueString())
+		t, errT := time.Parse(time.RFC3339, s)
+		if errP == nil && errT == nil && p.Equal(t) {
+			return prior
+		}
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,192 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &SingletonResource{}
+
+// SingletonResource implements the directus_singleton resource for
+// collections flagged as singletons
+type SingletonResource struct{ client *client.Directus }
+
+// SingletonModel represents the singleton resource model
+type SingletonModel struct {
+	ID             types.String `tfsdk:"id"` // same as collection
+	Collection     types.String `tfsdk:"collection"`
+	Data           jsonValue    `tfsdk:"data"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
+}
+
+// NewSingletonResource returns a new singleton resource
+func NewSingletonResource() resource.Resource { return &SingletonResource{} }
+
+// Metadata returns the resource type name
+func (r *SingletonResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_singleton"
+}
+
+// Schema defines the schema for the resource
+func (r *SingletonResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true},
+			"collection": rschema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"data": rschema.StringAttribute{
+				Required:    true,
+				CustomType:  jsonType{},
+				Description: "JSON object of the singleton's fields. Only the fields present here are managed.",
+			},
+			"reset_on_destroy": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Set the managed fields to null on destroy instead of leaving them untouched.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *SingletonResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create patches the singleton; it always exists so nothing is created
+func (r *SingletonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SingletonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Collection
+	r.patch(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the managed fields of the singleton
+func (r *SingletonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SingletonModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.Request(ctx, http.MethodGet, "/items/"+state.Collection.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	state.ID = state.Collection
+	state.Data = managedFields(state.Data, apiResp.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update patches the singleton
+func (r *SingletonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SingletonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Collection
+	r.patch(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is a no-op unless reset_on_destroy is set, in which case the managed
+// fields are cleared
+func (r *SingletonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SingletonModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ResetOnDestroy.ValueBool() {
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(state.Data.ValueString()), &fields); err != nil {
+			resp.Diagnostics.AddError("invalid data", err.Error())
+			return
+		}
+		payload := make(map[string]any, len(fields))
+		for k := range fields {
+			payload[k] = nil
+		}
+
+		httpResp, err := r.client.Request(ctx, http.MethodPatch, "/items/"+state.Collection.ValueString(), payload)
+		if err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+		defer httpResp.Body.Close()
+		if err := parseResp(httpResp, nil); err != nil {
+			resp.Diagnostics.AddError("api error", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a singleton by collection name
+func (r *SingletonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_on_destroy"), false)...)
+}
+
+// helper: PATCH the planned fields and read them back into the model
+func (r *SingletonResource) patch(ctx context.Context, plan *SingletonModel, diags diagCollector) {
+	httpResp, err := r.client.Request(ctx, http.MethodPatch, "/items/"+plan.Collection.ValueString(), jsonRaw(plan.Data.ValueString()))
+	if err != nil {
+		diags.AddError("api error", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	apiResp := struct {
+		Data map[string]any `json:"data"`
+	}{}
+	if err := parseResp(httpResp, &apiResp); err != nil {
+		diags.AddError("api error", err.Error())
+		return
+	}
+
+	plan.Data = managedFields(plan.Data, apiResp.Data)
+}
@@ -0,0 +1,315 @@
+package resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/soft-techies-com/terraform-provider-directus/internal/client"
+)
+
+var _ resource.ResourceWithImportState = &TranslationsResource{}
+
+// TranslationsResource implements the directus_translations resource, an
+// authoritative bulk variant of directus_translation
+type TranslationsResource struct{ client *client.Directus }
+
+// TranslationsModel represents the translations resource model
+type TranslationsModel struct {
+	ID           types.String `tfsdk:"id"`
+	Translations types.Map    `tfsdk:"translations"` // key -> language -> value
+}
+
+// NewTranslationsResource returns a new translations resource
+func NewTranslationsResource() resource.Resource { return &TranslationsResource{} }
+
+// Metadata returns the resource type name
+func (r *TranslationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_translations"
+}
+
+// Schema defines the schema for the resource
+func (r *TranslationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{Computed: true, Description: "hash of the managed translation keys"},
+			"translations": rschema.MapAttribute{
+				Required:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+				Description: "Map of translation key to a map of language code to value. Every translation " +
+					"stored under one of these keys is managed: languages missing here are deleted.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource
+func (r *TranslationsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Directus)
+}
+
+// Create creates the translations
+func (r *TranslationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TranslationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := map[string]map[string]string{}
+	resp.Diagnostics.Append(plan.Translations.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, desired, mapKeys(desired)); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(translationsID(mapKeys(desired)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the translations stored under the managed keys
+func (r *TranslationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TranslationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := map[string]map[string]string{}
+	resp.Diagnostics.Append(state.Translations.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows, err := r.list(ctx, mapKeys(current))
+	if err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	// Keep every managed key, even without any translation left, so keys
+	// configured with an empty map don't show up as a diff
+	found := make(map[string]map[string]string, len(current))
+	for key := range current {
+		found[key] = map[string]string{}
+	}
+	for _, row := range rows {
+		key, lang := str(row["key"]), str(row["language"])
+		if found[key] == nil {
+			continue
+		}
+		if _, dup := found[key][lang]; !dup {
+			found[key][lang] = str(row["value"])
+		}
+	}
+
+	translations, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, found)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue(translationsID(mapKeys(current)))
+	state.Translations = translations
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update reconciles the translations with the plan
+func (r *TranslationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TranslationsModel
+	var state TranslationsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := map[string]map[string]string{}
+	current := map[string]map[string]string{}
+	resp.Diagnostics.Append(plan.Translations.ElementsAs(ctx, &desired, false)...)
+	resp.Diagnostics.Append(state.Translations.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys dropped from the config are cleaned up as well
+	keys := mapKeys(desired)
+	for k := range current {
+		if _, ok := desired[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	if err := r.reconcile(ctx, desired, keys); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(translationsID(mapKeys(desired)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes every translation stored under the managed keys
+func (r *TranslationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TranslationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := map[string]map[string]string{}
+	resp.Diagnostics.Append(state.Translations.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, nil, mapKeys(current)); err != nil {
+		resp.Diagnostics.AddError("api error", err.Error())
+		return
+	}
+}
+
+// ImportState imports the translations stored under a comma-separated list of keys
+func (r *TranslationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	keys := map[string]map[string]string{}
+	for _, key := range strings.Split(req.ID, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys[key] = map[string]string{}
+		}
+	}
+	if len(keys) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected a comma-separated list of translation keys, got: %q", req.ID),
+		)
+		return
+	}
+
+	translations, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, keys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), translationsID(mapKeys(keys)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("translations"), translations)...)
+}
+
+// Helper functions
+
+// translationsID derives the resource ID from the sorted managed keys
+func translationsID(keys []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// list returns every translation row stored under one of keys
+func (r *TranslationsResource) list(ctx context.Context, keys []string) ([]map[string]any, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	filter, err := json.Marshal(map[string]any{"key": map[string]any{"_in": keys}})
+	if err != nil {
+		return nil, err
+	}
+	return r.client.GetAll(ctx, "/translations", url.Values{
+		"filter": {string(filter)},
+		"fields": {"id,key,language,value"},
+	})
+}
+
+// reconcile makes the translations stored under keys match desired: rows
+// with a changed value are patched, missing ones created and the rest deleted.
+func (r *TranslationsResource) reconcile(ctx context.Context, desired map[string]map[string]string, keys []string) error {
+	rows, err := r.list(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	matched := map[[2]string]struct{}{}
+	var deletes []string
+	for _, row := range rows {
+		id, key, lang := str(row["id"]), str(row["key"]), str(row["language"])
+		want, ok := desired[key][lang]
+		if _, dup := matched[[2]string{key, lang}]; !ok || dup {
+			deletes = append(deletes, id)
+			continue
+		}
+		matched[[2]string{key, lang}] = struct{}{}
+		if str(row["value"]) == want {
+			continue
+		}
+		httpResp, err := r.client.Request(ctx, http.MethodPatch, "/translations/"+id, map[string]any{"value": want})
+		if err != nil {
+			return err
+		}
+		err = parseResp(httpResp, nil)
+		httpResp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("translation %s (%s): %w", key, lang, err)
+		}
+	}
+
+	var creates []map[string]any
+	for _, key := range mapKeys(desired) {
+		langs := desired[key]
+		for _, lang := range mapKeys(langs) {
+			if _, ok := matched[[2]string{key, lang}]; ok {
+				continue
+			}
+			creates = append(creates, map[string]any{"key": key, "language": lang, "value": langs[lang]})
+		}
+	}
+
+	if len(creates) > 0 {
+		httpResp, err := r.client.Request(ctx, http.MethodPost, "/translations", creates)
+		if err != nil {
+			return err
+		}
+		err = parseResp(httpResp, nil)
+		httpResp.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(deletes) > 0 {
+		httpResp, err := r.client.Request(ctx, http.MethodDelete, "/translations", deletes)
+		if err != nil {
+			return err
+		}
+		err = parseResp(httpResp, nil)
+		httpResp.Body.Close()
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapKeys returns the keys of m in sorted order
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}